@@ -0,0 +1,83 @@
+package shortuuid
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// auditedVersion is the current scheme version of audited short IDs
+	auditedVersion = 1
+
+	// auditedTimeWidth is the number of base62 characters for the timestamp,
+	// enough for Unix milliseconds until the year 8888
+	auditedTimeWidth = 8
+)
+
+// ShortenUUIDAudited converts a UUID to a short ID that records when it was
+// minted, so auditors can check creation times. The layout is always 31 base62
+// characters:
+//
+//	character 1:      the scheme version, currently 1
+//	characters 2-9:   t in Unix milliseconds, left-padded with '0'
+//	characters 10-31: the ShortenUUID encoding, left-padded with '0'
+//
+// The timestamp is truncated to millisecond precision. Returns an
+// *EncodeError if t is before the Unix epoch or does not fit in 8 characters.
+func ShortenUUIDAudited(u uuid.UUID, t time.Time) (string, error) {
+	ms := t.UnixMilli()
+	stamp := defaultEncoder.intToBase(big.NewInt(ms))
+	if ms < 0 || len(stamp) > auditedTimeWidth {
+		return "", &EncodeError{
+			Input:  t.Format(time.RFC3339Nano),
+			Reason: fmt.Sprintf("timestamp does not fit the %d-character audit header", auditedTimeWidth),
+		}
+	}
+
+	short, err := defaultEncoder.ShortenUUID(u)
+	if err != nil {
+		return "", err
+	}
+
+	return string(defaultBase62[auditedVersion]) + defaultEncoder.padLeft(stamp, auditedTimeWidth) + defaultEncoder.padLeft(short, uuidWidth), nil
+}
+
+// ExpandUUIDAudited converts a short ID created by ShortenUUIDAudited back to
+// its UUID, creation time in UTC and scheme version.
+// Returns a *DecodeError if the length is wrong, the scheme version is not
+// supported, or either part is invalid.
+func ExpandUUIDAudited(s string) (u uuid.UUID, t time.Time, version int, err error) {
+	const width = 1 + auditedTimeWidth + uuidWidth
+	if len(s) != width {
+		return uuid.UUID{}, time.Time{}, 0, &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("invalid audited ID length: expected %d characters, got %d", width, len(s)),
+			Code:    CodeBadLength,
+		}
+	}
+
+	version = runeIndex(defaultBase62, rune(s[0]))
+	if version != auditedVersion {
+		return uuid.UUID{}, time.Time{}, 0, &DecodeError{
+			ShortID:  s,
+			Reason:   fmt.Sprintf("unsupported audit scheme version '%c': expected %d", s[0], auditedVersion),
+			Position: 1,
+			Code:     CodeMalformed,
+		}
+	}
+
+	ms, err := DecodeUint64(s[1 : 1+auditedTimeWidth])
+	if err != nil {
+		return uuid.UUID{}, time.Time{}, 0, err
+	}
+
+	u, err = defaultEncoder.ExpandUUID(s[1+auditedTimeWidth:])
+	if err != nil {
+		return uuid.UUID{}, time.Time{}, 0, err
+	}
+
+	return u, time.UnixMilli(int64(ms)).UTC(), version, nil
+}
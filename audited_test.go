@@ -0,0 +1,111 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenUUIDAudited(t *testing.T) {
+	u := uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d")
+
+	testCases := []time.Time{
+		time.Unix(0, 0),
+		time.Date(2026, 10, 15, 9, 30, 0, 123000000, time.UTC),
+		time.Date(2024, 2, 29, 23, 59, 59, 999000000, time.FixedZone("EST", -5*3600)),
+		time.Date(8000, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, minted := range testCases {
+		t.Run(minted.String(), func(t *testing.T) {
+			short, err := ShortenUUIDAudited(u, minted)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			if len(short) != 31 {
+				t.Errorf("Expected 31 characters, got %d: %s", len(short), short)
+			}
+
+			expanded, stamp, version, err := ExpandUUIDAudited(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != u {
+				t.Errorf("Expected %s, got %s", u, expanded)
+			}
+
+			if !stamp.Equal(minted) {
+				t.Errorf("Expected %s, got %s", minted, stamp)
+			}
+
+			if version != 1 {
+				t.Errorf("Expected version 1, got %d", version)
+			}
+		})
+	}
+}
+
+func TestShortenUUIDAuditedTruncatesToMilliseconds(t *testing.T) {
+	minted := time.Date(2026, 10, 15, 9, 30, 0, 123456789, time.UTC)
+
+	short, err := ShortenUUIDAudited(uuid.Nil, minted)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	_, stamp, _, err := ExpandUUIDAudited(short)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", short, err)
+	}
+
+	if want := minted.Truncate(time.Millisecond); !stamp.Equal(want) {
+		t.Errorf("Expected %s, got %s", want, stamp)
+	}
+}
+
+func TestShortenUUIDAuditedOutOfRange(t *testing.T) {
+	for _, minted := range []time.Time{time.Unix(-1, 0), time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC)} {
+		_, err := ShortenUUIDAudited(uuid.Nil, minted)
+
+		var encodeErr *EncodeError
+		if !errors.As(err, &encodeErr) {
+			t.Errorf("Expected EncodeError for %s, got %v", minted, err)
+		}
+	}
+}
+
+func TestExpandUUIDAuditedInvalid(t *testing.T) {
+	short, err := ShortenUUIDAudited(uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d"), time.Unix(1700000000, 0))
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	testCases := []struct {
+		name  string
+		input string
+		code  ErrorCode
+	}{
+		{"version mismatch", "2" + short[1:], CodeMalformed},
+		{"truncated", short[:len(short)-1], CodeBadLength},
+		{"invalid character", short[:12] + "@" + short[13:], CodeInvalidChar},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, _, err := ExpandUUIDAudited(tc.input)
+
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("Expected DecodeError for %s, got %T: %v", tc.input, err, err)
+			}
+
+			if decodeErr.Code != tc.code {
+				t.Errorf("Expected code %s, got %s", tc.code, decodeErr.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package shortuuid
+
+import "github.com/google/uuid"
+
+// ShortenUUIDsAligned encodes every UUID in us and left-pads the results with
+// '0' to the length of the longest one, returning the short IDs and their
+// common width. Widths are consistent within the batch without enforcing the
+// global 22-character maximum. The padding decodes away: ExpandUUID returns the
+// original UUIDs.
+func ShortenUUIDsAligned(us []uuid.UUID) ([]string, int, error) {
+	shorts := make([]string, len(us))
+	width := 0
+
+	for i, u := range us {
+		short, err := defaultEncoder.ShortenUUID(u)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		shorts[i] = short
+		if len(short) > width {
+			width = len(short)
+		}
+	}
+
+	for i, short := range shorts {
+		shorts[i] = defaultEncoder.padLeft(short, width)
+	}
+
+	return shorts, width, nil
+}
+
+// FindDuplicates returns every short ID that appears more than once in ids,
+// mapped to the indices of all its occurrences in ascending order. IDs are
+// compared exactly, so forms that differ only by padding are distinct; use
+// Canonicalize first to compare by value. The result is empty if all IDs are
+// unique.
+func FindDuplicates(ids []string) map[string][]int {
+	positions := make(map[string][]int, len(ids))
+	for i, id := range ids {
+		positions[id] = append(positions[id], i)
+	}
+
+	duplicates := make(map[string][]int)
+	for id, indices := range positions {
+		if len(indices) > 1 {
+			duplicates[id] = indices
+		}
+	}
+
+	return duplicates
+}
@@ -0,0 +1,106 @@
+package shortuuid
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenUUIDsAligned(t *testing.T) {
+	testCases := []struct {
+		name  string
+		uuids []string
+		width int
+	}{
+		{
+			name:  "mixed widths",
+			uuids: []string{"53a8d1b9-4eca-4888-9b59-8fa91497857b", "1d152c86-c436-4d47-9269-006c7469b867", "00000000-0000-0000-0000-00000000002a"},
+			width: 22,
+		},
+		{
+			name:  "all narrow",
+			uuids: []string{"00000000-0000-0000-0000-00000000002a", "00000000-0000-0000-0000-000000001000"},
+			width: 3,
+		},
+		{
+			name:  "empty",
+			uuids: nil,
+			width: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var us []uuid.UUID
+			for _, s := range tc.uuids {
+				us = append(us, uuid.MustParse(s))
+			}
+
+			shorts, width, err := ShortenUUIDsAligned(us)
+			if err != nil {
+				t.Fatalf("Error shortening UUIDs: %v", err)
+			}
+
+			if width != tc.width {
+				t.Errorf("Expected width %d, got %d", tc.width, width)
+			}
+
+			for i, short := range shorts {
+				if len(short) != width {
+					t.Errorf("Expected width %d, got %d in %s", width, len(short), short)
+				}
+
+				expanded, err := ExpandUUID(short)
+				if err != nil {
+					t.Fatalf("Error expanding short ID %s: %v", short, err)
+				}
+
+				if expanded != us[i] {
+					t.Errorf("Expected %s, got %s", us[i], expanded)
+				}
+			}
+		})
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ids      []string
+		expected map[string][]int
+	}{
+		{
+			name:     "unique",
+			ids:      []string{"2XrVqpuNYMfp5OSuawGnL1", "45VWNy74cXYBydTM0JO3rv", "Grm6f7QVJVuVrufEOTgIC"},
+			expected: map[string][]int{},
+		},
+		{
+			name:     "empty",
+			ids:      nil,
+			expected: map[string][]int{},
+		},
+		{
+			name: "duplicates",
+			ids:  []string{"abc", "def", "abc", "ghi", "def", "abc"},
+			expected: map[string][]int{
+				"abc": {0, 2, 5},
+				"def": {1, 4},
+			},
+		},
+		{
+			name:     "padding is significant",
+			ids:      []string{"0Grm6f7QVJVuVrufEOTgIC", "Grm6f7QVJVuVrufEOTgIC"},
+			expected: map[string][]int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			duplicates := FindDuplicates(tc.ids)
+			if !reflect.DeepEqual(duplicates, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, duplicates)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package shortuuid
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// AppendCheckChar returns short with a single base62 check character appended.
+// The check character is computed with the Luhn mod N algorithm over the base62
+// alphabet, which catches every single-character substitution and most adjacent
+// transpositions. Systems unaware of the check character can simply drop the
+// last character. Characters outside the base62 alphabet do not contribute to
+// the checksum, so short should be a valid short ID.
+func AppendCheckChar(short string) string {
+	return short + string(checkChar([]rune(short)))
+}
+
+// VerifyCheckChar validates the trailing check character of a string produced by
+// AppendCheckChar. It returns the short ID without the check character and
+// whether the check character matched. Inputs shorter than two characters or
+// containing characters outside the base62 alphabet never verify.
+func VerifyCheckChar(shortWithCheck string) (string, bool) {
+	runes := []rune(shortWithCheck)
+	if len(runes) < 2 {
+		return "", false
+	}
+
+	body := runes[:len(runes)-1]
+	for _, char := range body {
+		if runeIndex(defaultBase62, char) == -1 {
+			return "", false
+		}
+	}
+
+	if checkChar(body) != runes[len(runes)-1] {
+		return "", false
+	}
+
+	return string(body), true
+}
+
+// ExpandUUIDDeferred decodes a short ID that carries a check character from
+// AppendCheckChar, but defers validating the check character: it returns the
+// UUID decoded from the body together with a verify function. Callers can run
+// verify later, in parallel across a large batch, or skip it for trusted
+// sources. verify returns a *DecodeError wrapping ErrChecksumMismatch if the
+// check character does not match. The returned error covers only decoding.
+func ExpandUUIDDeferred(shortWithCheck string) (u uuid.UUID, verify func() error, err error) {
+	runes := []rune(shortWithCheck)
+	if len(runes) < 2 {
+		return uuid.UUID{}, nil, &DecodeError{
+			ShortID: shortWithCheck,
+			Reason:  "short ID is too short to carry a check character",
+			Err:     ErrTruncated,
+			Code:    CodeBadLength,
+		}
+	}
+
+	body := runes[:len(runes)-1]
+	u, err = defaultEncoder.ExpandUUID(string(body))
+	if err != nil {
+		return uuid.UUID{}, nil, err
+	}
+
+	verify = func() error {
+		if checkChar(body) != runes[len(runes)-1] {
+			return &DecodeError{
+				ShortID: shortWithCheck,
+				Reason:  "check character does not match",
+				Err:     ErrChecksumMismatch,
+				Code:    CodeChecksum,
+			}
+		}
+		return nil
+	}
+
+	return u, verify, nil
+}
+
+// urlWidth is the length of a ShortenUUIDURL token: a padded UUID plus a check character
+const urlWidth = uuidWidth + 1
+
+// ShortenUUIDURL converts a uuid.UUID to a fixed-width, URL-safe token for
+// public web IDs. The layout is always 23 base62 characters:
+//
+//	characters 1-22: the ShortenUUID encoding, left-padded with '0'
+//	character 23:    the AppendCheckChar check character over characters 1-22
+//
+// Base62 characters are unreserved in URLs, so the token needs no escaping in
+// a path segment. Clients can validate a token by checking its length and
+// recomputing the Luhn mod N check character over the first 22 characters.
+func ShortenUUIDURL(u uuid.UUID) (string, error) {
+	short, err := defaultEncoder.ShortenUUID(u)
+	if err != nil {
+		return "", err
+	}
+
+	return AppendCheckChar(defaultEncoder.padLeft(short, uuidWidth)), nil
+}
+
+// ExpandUUIDURL converts a token created by ShortenUUIDURL back to a UUID.
+// Returns a *DecodeError wrapping ErrTruncated if the token is shorter than 23
+// characters, or wrapping ErrChecksumMismatch if the check character does not
+// match, which catches every single-character typo.
+func ExpandUUIDURL(token string) (uuid.UUID, error) {
+	if len(token) != urlWidth {
+		decodeErr := &DecodeError{
+			ShortID: token,
+			Reason:  fmt.Sprintf("invalid URL token length: expected %d characters, got %d", urlWidth, len(token)),
+			Code:    CodeBadLength,
+		}
+		if len(token) < urlWidth {
+			decodeErr.Err = ErrTruncated
+		}
+		return uuid.UUID{}, decodeErr
+	}
+
+	u, err := defaultEncoder.ExpandUUID(token[:uuidWidth])
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if _, ok := VerifyCheckChar(token); !ok {
+		return uuid.UUID{}, &DecodeError{
+			ShortID: token,
+			Reason:  "check character does not match",
+			Err:     ErrChecksumMismatch,
+			Code:    CodeChecksum,
+		}
+	}
+
+	return u, nil
+}
+
+// checkChar computes the Luhn mod N check character for body over the base62 alphabet
+func checkChar(body []rune) rune {
+	n := len(defaultBase62)
+	factor := 2
+	sum := 0
+
+	// Walk from the rightmost character, doubling every other code point
+	for i := len(body) - 1; i >= 0; i-- {
+		codePoint := runeIndex(defaultBase62, body[i])
+		if codePoint == -1 {
+			continue
+		}
+
+		addend := factor * codePoint
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+
+		sum += addend/n + addend%n
+	}
+
+	return defaultBase62[(n-sum%n)%n]
+}
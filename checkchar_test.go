@@ -0,0 +1,197 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestCheckChar(t *testing.T) {
+	testCases := []string{
+		"2XrVqpuNYMfp5OSuawGnL1",
+		"ssS9A1oUhTFAbdjd6w93P",
+		"AAwf93rvy4aWQVw",
+		"0",
+		"z",
+	}
+
+	for _, short := range testCases {
+		t.Run(short, func(t *testing.T) {
+			withCheck := AppendCheckChar(short)
+			if len(withCheck) != len(short)+1 {
+				t.Fatalf("Expected one extra character, got %q", withCheck)
+			}
+
+			body, ok := VerifyCheckChar(withCheck)
+			if !ok {
+				t.Fatalf("Expected %s to verify", withCheck)
+			}
+
+			if body != short {
+				t.Errorf("Expected body %s, got %s", short, body)
+			}
+
+			t.Logf("%s -> %s", short, withCheck)
+		})
+	}
+}
+
+func TestCheckCharTampered(t *testing.T) {
+	short, err := ShortenUUID(uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"))
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+	withCheck := []rune(AppendCheckChar(short))
+
+	// Every single-character substitution must be detected
+	for i := range withCheck {
+		for _, replacement := range defaultBase62 {
+			if replacement == withCheck[i] {
+				continue
+			}
+
+			tampered := make([]rune, len(withCheck))
+			copy(tampered, withCheck)
+			tampered[i] = replacement
+
+			if _, ok := VerifyCheckChar(string(tampered)); ok {
+				t.Fatalf("Expected tampered ID %s to fail verification", string(tampered))
+			}
+		}
+	}
+
+	// Adjacent transpositions of distinct characters
+	swapped := make([]rune, len(withCheck))
+	copy(swapped, withCheck)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	if _, ok := VerifyCheckChar(string(swapped)); ok {
+		t.Errorf("Expected transposed ID %s to fail verification", string(swapped))
+	}
+}
+
+func TestCheckCharInvalid(t *testing.T) {
+	testCases := []string{"", "A", "@#$%", "abc-"}
+
+	for _, input := range testCases {
+		if _, ok := VerifyCheckChar(input); ok {
+			t.Errorf("Expected %q to fail verification", input)
+		}
+	}
+}
+
+func TestExpandUUIDDeferred(t *testing.T) {
+	u := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+	short, err := ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+	withCheck := AppendCheckChar(short)
+
+	expanded, verify, err := ExpandUUIDDeferred(withCheck)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", withCheck, err)
+	}
+
+	if expanded != u {
+		t.Errorf("Expected %s, got %s", u, expanded)
+	}
+
+	if err := verify(); err != nil {
+		t.Errorf("Expected verification to pass, got %v", err)
+	}
+
+	// A wrong check character still decodes, but fails verification
+	wrong := short + "0"
+	if wrong == withCheck {
+		wrong = short + "1"
+	}
+
+	expanded, verify, err = ExpandUUIDDeferred(wrong)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", wrong, err)
+	}
+
+	if expanded != u {
+		t.Errorf("Expected %s, got %s", u, expanded)
+	}
+
+	if err := verify(); !errors.Is(err, ErrChecksumMismatch) {
+		t.Errorf("Expected ErrChecksumMismatch, got %v", err)
+	}
+}
+
+func TestExpandUUIDDeferredInvalid(t *testing.T) {
+	for _, input := range []string{"", "0", "2XrVqpuN@Mfp5OSuawGnL1x"} {
+		_, verify, err := ExpandUUIDDeferred(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q, got %T: %v", input, err, err)
+		}
+
+		if verify != nil {
+			t.Errorf("Expected no verify function for %q", input)
+		}
+	}
+}
+
+func TestShortenUUIDURL(t *testing.T) {
+	testCases := []uuid.UUID{
+		uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d"),
+		uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		uuid.Max,
+	}
+
+	for _, u := range testCases {
+		t.Run(u.String(), func(t *testing.T) {
+			token, err := ShortenUUIDURL(u)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			if len(token) != 23 {
+				t.Errorf("Expected 23 characters, got %d: %s", len(token), token)
+			}
+
+			expanded, err := ExpandUUIDURL(token)
+			if err != nil {
+				t.Fatalf("Error expanding token %s: %v", token, err)
+			}
+
+			if expanded != u {
+				t.Errorf("Expected %s, got %s", u, expanded)
+			}
+		})
+	}
+}
+
+func TestExpandUUIDURLInvalid(t *testing.T) {
+	token, err := ShortenUUIDURL(uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d"))
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	replacement := byte('A')
+	if token[7] == replacement {
+		replacement = 'B'
+	}
+	typo := token[:7] + string(replacement) + token[8:]
+
+	testCases := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{"typo", typo, ErrChecksumMismatch},
+		{"truncated", token[:len(token)-1], ErrTruncated},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ExpandUUIDURL(tc.token)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("Expected %v for %s, got %v", tc.err, tc.token, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package shortuuid
+
+// Checksummer computes and verifies the check value that an encoder configured
+// with WithChecksum appends to UUID short IDs as one extra character. Plug in
+// an implementation to match the check scheme of another system, such as Luhn,
+// Verhoeff or Damm.
+type Checksummer interface {
+	// Compute returns the check value for data, which must be less than the
+	// number of characters in the encoder alphabet; the check character is the
+	// alphabet character at that position.
+	Compute(data []byte) byte
+	// Verify reports whether check is the correct check value for data.
+	Verify(data []byte, check byte) bool
+}
+
+// WithChecksum makes ShortenUUID append a check character computed by c over
+// the UUID's 16 bytes, and ExpandUUID verify it, returning a *DecodeError
+// wrapping ErrChecksumMismatch on mismatch. With WithStrictWidth the check
+// character follows the padded UUID. Shorten and Expand are unaffected.
+// Use Mod62Checksum for a ready-made scheme.
+func WithChecksum(c Checksummer) Option {
+	return func(e *Encoder) {
+		e.checksum = c
+	}
+}
+
+// Mod62Checksum is a position-weighted sum of the data bytes modulo 62, with
+// weights 1 to 16 over a UUID. It catches every change of a single byte by
+// less than 31 either way, and every swap of two adjacent bytes that differ by
+// less than 62. Larger changes can go unnoticed: a byte at an odd weight
+// changing by 62, or at an even weight by 31, leaves the sum unchanged. Its
+// values need an alphabet of at least 62 characters, such as the default one.
+var Mod62Checksum Checksummer = mod62Checksum{}
+
+// mod62Checksum implements Mod62Checksum
+type mod62Checksum struct{}
+
+func (mod62Checksum) Compute(data []byte) byte {
+	sum := 0
+	for i, b := range data {
+		sum += (i + 1) * int(b)
+	}
+	return byte(sum % 62)
+}
+
+func (c mod62Checksum) Verify(data []byte, check byte) bool {
+	return c.Compute(data) == check
+}
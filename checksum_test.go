@@ -0,0 +1,158 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// xorChecksum is a custom Checksummer that XORs the data bytes into 4 bits
+type xorChecksum struct{}
+
+func (xorChecksum) Compute(data []byte) byte {
+	var sum byte
+	for _, b := range data {
+		sum ^= b
+	}
+	return sum & 0x0f
+}
+
+func (c xorChecksum) Verify(data []byte, check byte) bool {
+	return c.Compute(data) == check
+}
+
+func TestWithChecksum(t *testing.T) {
+	testCases := map[string]Checksummer{
+		"default": Mod62Checksum,
+		"custom":  xorChecksum{},
+	}
+
+	u := uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d")
+
+	for name, checksummer := range testCases {
+		t.Run(name, func(t *testing.T) {
+			enc, err := NewEncoder(DefaultAlphabet, WithChecksum(checksummer))
+			if err != nil {
+				t.Fatalf("Error creating encoder: %v", err)
+			}
+
+			short, err := enc.ShortenUUID(u)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			plain, err := ShortenUUID(u)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			want := plain + string(DefaultAlphabet[checksummer.Compute(u[:])])
+			if short != want {
+				t.Errorf("Expected %s, got %s", want, short)
+			}
+
+			expanded, err := enc.ExpandUUID(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != u {
+				t.Errorf("Expected %s, got %s", u, expanded)
+			}
+
+			// A different check character must be rejected
+			last := DefaultAlphabet[(checksummer.Compute(u[:])+1)%62]
+			_, err = enc.ExpandUUID(plain + string(last))
+			if !errors.Is(err, ErrChecksumMismatch) {
+				t.Errorf("Expected ErrChecksumMismatch, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWithChecksumTypo(t *testing.T) {
+	enc, err := NewEncoder(DefaultAlphabet, WithChecksum(Mod62Checksum))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	short, err := enc.ShortenUUID(uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d"))
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	replacement := byte('A')
+	if short[5] == replacement {
+		replacement = 'B'
+	}
+	typo := short[:5] + string(replacement) + short[6:]
+
+	_, err = enc.ExpandUUID(typo)
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("Expected ErrChecksumMismatch for %s, got %v", typo, err)
+	}
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Code != CodeChecksum {
+		t.Errorf("Expected CodeChecksum, got %v", err)
+	}
+}
+
+func TestWithChecksumSmallAlphabet(t *testing.T) {
+	enc, err := NewEncoder("0123456789", WithChecksum(Mod62Checksum))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	// The check value of this UUID is 36, which does not fit the alphabet
+	u := uuid.UUID{15: 10}
+	_, err = enc.ShortenUUID(u)
+
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Errorf("Expected *EncodeError for check value %d, got %v", Mod62Checksum.Compute(u[:]), err)
+	}
+}
+
+func TestMod62ChecksumGuarantees(t *testing.T) {
+	u := uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d")
+	check := Mod62Checksum.Compute(u[:])
+
+	// Every single-byte change by less than 31 is caught
+	for i := range u {
+		for delta := 1; delta < 31; delta++ {
+			for _, d := range []int{delta, -delta} {
+				value := int(u[i]) + d
+				if value < 0 || value > 255 {
+					continue
+				}
+				changed := u
+				changed[i] = byte(value)
+				if Mod62Checksum.Verify(changed[:], check) {
+					t.Errorf("Expected change of %d at byte %d to be caught", d, i)
+				}
+			}
+		}
+	}
+
+	// Every swap of adjacent bytes differing by less than 62 is caught
+	for i := 0; i < len(u)-1; i++ {
+		diff := int(u[i]) - int(u[i+1])
+		if diff == 0 || diff <= -62 || diff >= 62 {
+			continue
+		}
+		swapped := u
+		swapped[i], swapped[i+1] = swapped[i+1], swapped[i]
+		if Mod62Checksum.Verify(swapped[:], check) {
+			t.Errorf("Expected swap of bytes %d and %d to be caught", i, i+1)
+		}
+	}
+
+	// Documented blind spot: a change by 62 at any weight
+	changed := u
+	changed[15] += 62
+	if !Mod62Checksum.Verify(changed[:], check) {
+		t.Errorf("Expected a change of 62 to leave the checksum unchanged")
+	}
+}
@@ -0,0 +1,25 @@
+package shortuuid
+
+import "github.com/google/uuid"
+
+// Shortener mints short IDs. Accept a Shortener in services that only create
+// IDs.
+type Shortener interface {
+	Shorten(input string) (string, error)
+	ShortenUUID(u uuid.UUID) (string, error)
+}
+
+// Decoder reads short IDs back. Accept a Decoder in read-only services so
+// they cannot accidentally mint new IDs.
+type Decoder interface {
+	Expand(shortID string) (string, error)
+	ExpandUUID(shortID string) (uuid.UUID, error)
+}
+
+// Both Encoder and FrozenEncoder implement Shortener and Decoder
+var (
+	_ Shortener = (*Encoder)(nil)
+	_ Decoder   = (*Encoder)(nil)
+	_ Shortener = (*FrozenEncoder)(nil)
+	_ Decoder   = (*FrozenEncoder)(nil)
+)
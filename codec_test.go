@@ -0,0 +1,56 @@
+package shortuuid
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenerAndDecoder(t *testing.T) {
+	u := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+
+	testCases := map[string]struct {
+		shortener Shortener
+		decoder   Decoder
+	}{
+		"encoder": {defaultEncoder, defaultEncoder},
+		"frozen":  {defaultEncoder.Freeze(), defaultEncoder.Freeze()},
+		"mixed":   {defaultEncoder, defaultEncoder.Freeze()},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			short, err := tc.shortener.ShortenUUID(u)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			if short != "Grm6f7QVJVuVrufEOTgIC" {
+				t.Errorf("Expected Grm6f7QVJVuVrufEOTgIC, got %s", short)
+			}
+
+			expanded, err := tc.decoder.ExpandUUID(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != u {
+				t.Errorf("Expected %s, got %s", u, expanded)
+			}
+
+			shortString, err := tc.shortener.Shorten("hello world")
+			if err != nil {
+				t.Fatalf("Error shortening string: %v", err)
+			}
+
+			expandedString, err := tc.decoder.Expand(shortString)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", shortString, err)
+			}
+
+			if expandedString != "hello world" {
+				t.Errorf("Expected hello world, got %s", expandedString)
+			}
+		})
+	}
+}
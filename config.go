@@ -0,0 +1,49 @@
+package shortuuid
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// NewEncoderFromReader creates an Encoder from an alphabet definition read
+// from r, so the alphabet can be managed in configuration outside the code.
+// The definition is plain text with the alphabet on a single line:
+//
+//	# Rotated 2026-10-01
+//	0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz
+//
+// Blank lines and lines starting with '#' are ignored, and surrounding
+// whitespace is trimmed, so an alphabet cannot contain whitespace. The
+// alphabet is validated like NewEncoder and opts are applied as with
+// NewEncoder. Returns an *EncodeError if the definition has no alphabet line,
+// more than one, or an invalid alphabet; read errors are returned unchanged.
+func NewEncoderFromReader(r io.Reader, opts ...Option) (*Encoder, error) {
+	scanner := bufio.NewScanner(r)
+	var alphabets []string
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		alphabets = append(alphabets, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	switch len(alphabets) {
+	case 0:
+		return nil, &EncodeError{
+			Reason: "alphabet definition contains no alphabet line",
+		}
+	case 1:
+		return NewEncoder(alphabets[0], opts...)
+	default:
+		return nil, &EncodeError{
+			Input:  strings.Join(alphabets, "\n"),
+			Reason: "alphabet definition contains more than one alphabet line",
+		}
+	}
+}
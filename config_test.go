@@ -0,0 +1,77 @@
+package shortuuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/google/uuid"
+)
+
+func TestNewEncoderFromReader(t *testing.T) {
+	testCases := map[string]string{
+		"single line":   "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+		"trailing CRLF": "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz\r\n",
+		"comments":      "# Rotated 2026-10-01\n\n  0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz  \n# end\n",
+	}
+
+	u := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+
+	for name, definition := range testCases {
+		t.Run(name, func(t *testing.T) {
+			encoder, err := NewEncoderFromReader(strings.NewReader(definition))
+			if err != nil {
+				t.Fatalf("Error creating encoder: %v", err)
+			}
+
+			short, err := encoder.ShortenUUID(u)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			if short != "Grm6f7QVJVuVrufEOTgIC" {
+				t.Errorf("Expected Grm6f7QVJVuVrufEOTgIC, got %s", short)
+			}
+		})
+	}
+}
+
+func TestNewEncoderFromReaderInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"empty":        "",
+		"only comment": "# no alphabet here\n",
+		"two lines":    "0123456789\nabcdef\n",
+		"duplicate":    "0123456789abcdefa\n",
+		"too short":    "0\n",
+	}
+
+	for name, definition := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewEncoderFromReader(strings.NewReader(definition))
+
+			var encodeErr *EncodeError
+			if !errors.As(err, &encodeErr) {
+				t.Errorf("Expected EncodeError, got %T: %v", err, err)
+			}
+		})
+	}
+}
+
+func TestNewEncoderFromReaderOptions(t *testing.T) {
+	_, err := NewEncoderFromReader(strings.NewReader("0123456789\n"), WithMinBase(32))
+
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Errorf("Expected EncodeError for alphabet below the minimum base, got %v", err)
+	}
+}
+
+func TestNewEncoderFromReaderReadError(t *testing.T) {
+	readErr := errors.New("config unavailable")
+
+	_, err := NewEncoderFromReader(iotest.ErrReader(readErr))
+	if !errors.Is(err, readErr) {
+		t.Errorf("Expected read error to be returned, got %v", err)
+	}
+}
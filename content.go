@@ -0,0 +1,41 @@
+package shortuuid
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+)
+
+// ShortenContent returns a content-addressed short ID for v, suitable as a
+// cache key: equal content yields equal short IDs. The value is serialized with
+// encoding/json, hashed with SHA-256, and the first 128 bits of the digest are
+// encoded. The result is not reversible.
+//
+// Determinism follows from encoding/json: struct fields are emitted in
+// declaration order and map keys are sorted. Values whose JSON form is not
+// stable, such as types with custom MarshalJSON methods that vary between
+// calls, or floats that differ only beyond JSON precision, do not get stable IDs.
+// Returns an error if v cannot be serialized.
+func ShortenContent(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", &EncodeError{
+			Input:  fmt.Sprintf("%v", v),
+			Reason: "failed to serialize content: " + err.Error(),
+		}
+	}
+
+	sum := sha256.Sum256(data)
+	return defaultEncoder.encodeBytes(sum[:16]), nil
+}
+
+// ShortenNameMD5 reproduces the short IDs of a legacy scheme that hashed a name
+// with MD5 and base62-encoded the full 128-bit digest as a big-endian integer,
+// without padding. It is deterministic but not reversible, and exists only to
+// regenerate those IDs during migration; MD5 is not collision resistant, so do
+// not use it for new IDs. Any name, including the empty string, is accepted.
+func ShortenNameMD5(name string) string {
+	sum := md5.Sum([]byte(name))
+	return defaultEncoder.encodeBytes(sum[:])
+}
@@ -0,0 +1,81 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+)
+
+type contentFixture struct {
+	Name  string
+	Tags  []string
+	Attrs map[string]int
+}
+
+func TestShortenContent(t *testing.T) {
+	a := contentFixture{Name: "widget", Tags: []string{"x", "y"}, Attrs: map[string]int{"a": 1, "b": 2, "c": 3}}
+	b := contentFixture{Name: "widget", Tags: []string{"x", "y"}, Attrs: map[string]int{"c": 3, "b": 2, "a": 1}}
+	c := contentFixture{Name: "gadget", Tags: []string{"x", "y"}, Attrs: map[string]int{"a": 1, "b": 2, "c": 3}}
+
+	shortA, err := ShortenContent(a)
+	if err != nil {
+		t.Fatalf("Error shortening content: %v", err)
+	}
+
+	shortB, err := ShortenContent(b)
+	if err != nil {
+		t.Fatalf("Error shortening content: %v", err)
+	}
+
+	shortC, err := ShortenContent(c)
+	if err != nil {
+		t.Fatalf("Error shortening content: %v", err)
+	}
+
+	if shortA != shortB {
+		t.Errorf("Expected equal content to produce equal IDs, got %s and %s", shortA, shortB)
+	}
+
+	if shortA == shortC {
+		t.Errorf("Expected different content to produce different IDs, both got %s", shortA)
+	}
+
+	if len(shortA) > 22 {
+		t.Errorf("Expected at most 22 characters, got %d", len(shortA))
+	}
+
+	t.Logf("a: %s, c: %s", shortA, shortC)
+}
+
+func TestShortenContentError(t *testing.T) {
+	_, err := ShortenContent(make(chan int))
+
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Fatalf("Expected EncodeError, got %T: %v", err, err)
+	}
+}
+
+func TestShortenNameMD5(t *testing.T) {
+	// Reference vectors from the legacy scheme, reproduced independently with
+	// Python: int.from_bytes(hashlib.md5(name).digest(), "big") in base62
+	testCases := []struct {
+		name     string
+		digest   string
+		expected string
+	}{
+		{"", "d41d8cd98f00b204e9800998ecf8427e", "6SFsQFoWZKg7DZ3I6vLDPK"},
+		{"hello", "5d41402abc4b2a76b9719d911017c592", "2py6ZcZ4zuqKEy47Zj8TXm"},
+		{"The quick brown fox jumps over the lazy dog", "9e107d9d372bb6826bd81d3542a419d6", "4oGK5AaNEEtflKvmIgkiBC"},
+		{"customer/42", "6713bfb60c8fa017143187ae9b2f5fdf", "38VEZ6a9TryjE7HTT5wjdP"},
+		{"héllo", "be50e8478cf24ff3595bc7307fb91b50", "5n7W2uPDm6xOxwQQDeLdb6"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			short := ShortenNameMD5(tc.name)
+			if short != tc.expected {
+				t.Errorf("Expected %s for MD5 %s, got %s", tc.expected, tc.digest, short)
+			}
+		})
+	}
+}
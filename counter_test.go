@@ -0,0 +1,172 @@
+package shortuuid
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	counter := NewCounter(60)
+
+	expected := []string{"0000000000y", "0000000000z", "00000000010", "00000000011"}
+	for i, want := range expected {
+		got := counter.Next()
+		if got != want {
+			t.Errorf("Expected ID %d to be %s, got %s", i, want, got)
+		}
+
+		v, err := DecodeUint64(got)
+		if err != nil {
+			t.Fatalf("Error decoding %s: %v", got, err)
+		}
+
+		if v != uint64(60+i) {
+			t.Errorf("Expected value %d, got %d", 60+i, v)
+		}
+	}
+
+	if got := NewCounter(math.MaxUint64).Next(); got != "LygHa16AHYF" {
+		t.Errorf("Expected LygHa16AHYF for max uint64, got %s", got)
+	}
+}
+
+func TestCounterConcurrent(t *testing.T) {
+	const goroutines = 8
+	const perGoroutine = 500
+
+	counter := NewCounter(0)
+	results := make([][]string, goroutines)
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				results[g] = append(results[g], counter.Next())
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	seen := make(map[string]bool)
+	var all []string
+	for _, ids := range results {
+		// Each goroutine observes strictly increasing IDs
+		if !sort.StringsAreSorted(ids) {
+			t.Error("Expected IDs from a single goroutine to be increasing")
+		}
+
+		for _, id := range ids {
+			if len(id) != counterWidth {
+				t.Fatalf("Expected width %d, got %d in %s", counterWidth, len(id), id)
+			}
+			if seen[id] {
+				t.Fatalf("Duplicate ID %s", id)
+			}
+			seen[id] = true
+			all = append(all, id)
+		}
+	}
+
+	// Together the IDs cover exactly 0..n-1
+	sort.Strings(all)
+	for i, id := range all {
+		v, err := DecodeUint64(id)
+		if err != nil {
+			t.Fatalf("Error decoding %s: %v", id, err)
+		}
+		if v != uint64(i) {
+			t.Fatalf("Expected value %d at position %d, got %d", i, i, v)
+		}
+	}
+}
+
+func TestCounterNextBatch(t *testing.T) {
+	counter := NewCounter(60)
+
+	batch, err := counter.NextBatch(3)
+	if err != nil {
+		t.Fatalf("Error reserving batch: %v", err)
+	}
+
+	expected := []string{"0000000000y", "0000000000z", "00000000010"}
+	for i, want := range expected {
+		if batch[i] != want {
+			t.Errorf("Expected ID %d to be %s, got %s", i, want, batch[i])
+		}
+	}
+
+	if got := counter.Next(); got != "00000000011" {
+		t.Errorf("Expected 00000000011 after the batch, got %s", got)
+	}
+
+	if batch, err := counter.NextBatch(0); err != nil || len(batch) != 0 {
+		t.Errorf("Expected empty batch, got %v, %v", batch, err)
+	}
+
+	_, err = counter.NextBatch(-1)
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Errorf("Expected EncodeError for negative batch size, got %T: %v", err, err)
+	} else if encodeErr.Input != "-1" {
+		t.Errorf("Expected input -1, got %q", encodeErr.Input)
+	}
+
+	// A batch ending exactly at the maximum is allowed; one more wraps
+	if _, err := NewCounter(math.MaxUint64 - 1).NextBatch(2); err != nil {
+		t.Errorf("Expected batch ending at the maximum to succeed, got %v", err)
+	}
+
+	if _, err := NewCounter(math.MaxUint64 - 1).NextBatch(3); err == nil {
+		t.Errorf("Expected error for batch wrapping past the maximum")
+	}
+}
+
+func TestCounterNextBatchConcurrent(t *testing.T) {
+	counter := NewCounter(0)
+
+	var mu sync.Mutex
+	var batches [][]string
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(size int) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				batch, err := counter.NextBatch(size)
+				if err != nil {
+					t.Errorf("Error reserving batch: %v", err)
+					return
+				}
+
+				mu.Lock()
+				batches = append(batches, batch)
+				mu.Unlock()
+			}
+		}(g + 1)
+	}
+	wg.Wait()
+
+	// Batches are contiguous blocks: ordered by first ID they form one sequence
+	sort.Slice(batches, func(i, j int) bool { return batches[i][0] < batches[j][0] })
+
+	var all []string
+	for _, batch := range batches {
+		all = append(all, batch...)
+	}
+
+	for i, id := range all {
+		v, err := DecodeUint64(id)
+		if err != nil {
+			t.Fatalf("Error decoding %s: %v", id, err)
+		}
+
+		if v != uint64(i) {
+			t.Fatalf("Expected ID %d to encode %d, got %d", i, i, v)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package shortuuid
+
+import (
+	"hash/crc32"
+	"math/big"
+)
+
+// crcWidth is the number of base62 characters needed for any CRC32 value
+const crcWidth = 6
+
+// ShortenWithCRC converts any string to a short ID like Shorten and appends the
+// IEEE CRC32 of the input, encoded in base62 and left-padded to 6 characters.
+// The checksum detects corruption of longer payloads far more reliably than a
+// single check character, at a fixed overhead of 6 characters per ID.
+// Returns an error if the input string is empty.
+func ShortenWithCRC(input string) (string, error) {
+	short, err := defaultEncoder.Shorten(input)
+	if err != nil {
+		return "", err
+	}
+
+	return short + crcDigits(input), nil
+}
+
+// ExpandWithCRC reverses ShortenWithCRC: it splits off the trailing 6-character
+// checksum, expands the payload, and verifies the checksum against it.
+// Returns a *DecodeError wrapping ErrChecksumMismatch if the checksum does not
+// match, or a *DecodeError if the short ID is too short or invalid.
+func ExpandWithCRC(shortID string) (string, error) {
+	if len(shortID) <= crcWidth {
+		return "", &DecodeError{
+			ShortID: shortID,
+			Reason:  "short ID is too short to carry a CRC32 checksum",
+			Err:     ErrTruncated,
+			Code:    CodeBadLength,
+		}
+	}
+
+	payload, checksum := shortID[:len(shortID)-crcWidth], shortID[len(shortID)-crcWidth:]
+
+	expanded, err := defaultEncoder.Expand(payload)
+	if err != nil {
+		return "", err
+	}
+
+	if crcDigits(expanded) != checksum {
+		return "", &DecodeError{
+			ShortID: shortID,
+			Reason:  "CRC32 checksum does not match the payload",
+			Err:     ErrChecksumMismatch,
+			Code:    CodeChecksum,
+		}
+	}
+
+	return expanded, nil
+}
+
+// crcDigits returns the base62 CRC32 of input padded to crcWidth
+func crcDigits(input string) string {
+	sum := new(big.Int).SetUint64(uint64(crc32.ChecksumIEEE([]byte(input))))
+	return defaultEncoder.padLeft(defaultEncoder.intToBase(sum), crcWidth)
+}
@@ -0,0 +1,86 @@
+package shortuuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestShortenWithCRC(t *testing.T) {
+	testCases := []string{
+		"a",
+		"hello world",
+		`{"user":42,"roles":["admin","editor"],"expires":"2026-12-31T00:00:00Z"}`,
+		strings.Repeat("payload ", 100),
+	}
+
+	for _, input := range testCases {
+		t.Run(input[:min(len(input), 20)], func(t *testing.T) {
+			short, err := ShortenWithCRC(input)
+			if err != nil {
+				t.Fatalf("Error shortening string: %v", err)
+			}
+
+			plain, err := Shorten(input)
+			if err != nil {
+				t.Fatalf("Error shortening string: %v", err)
+			}
+
+			if len(short) != len(plain)+6 {
+				t.Errorf("Expected %d characters, got %d", len(plain)+6, len(short))
+			}
+
+			expanded, err := ExpandWithCRC(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != input {
+				t.Errorf("Expected %q, got %q", input, expanded)
+			}
+		})
+	}
+}
+
+func TestExpandWithCRCCorruption(t *testing.T) {
+	short, err := ShortenWithCRC("hello world")
+	if err != nil {
+		t.Fatalf("Error shortening string: %v", err)
+	}
+
+	// replaceAt swaps the character at i for a different base62 character
+	replaceAt := func(s string, i int) string {
+		replacement := byte('A')
+		if s[i] == replacement {
+			replacement = 'B'
+		}
+		return s[:i] + string(replacement) + s[i+1:]
+	}
+
+	testCases := map[string]string{
+		"payload":  replaceAt(short, 2),
+		"checksum": replaceAt(short, len(short)-2),
+	}
+
+	for name, corrupted := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ExpandWithCRC(corrupted)
+			if !errors.Is(err, ErrChecksumMismatch) {
+				t.Errorf("Expected ErrChecksumMismatch for %s, got %v", corrupted, err)
+			}
+
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Errorf("Expected DecodeError, got %T: %v", err, err)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "abc123", short[:len(short)-7] + "@" + short[len(short)-6:]} {
+		_, err := ExpandWithCRC(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+}
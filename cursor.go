@@ -0,0 +1,39 @@
+package shortuuid
+
+import "github.com/google/uuid"
+
+// Cursor is an opaque pagination cursor backed by a UUID, such as the ID of the
+// last item on a page. Its string form is the UUID's base62 short ID,
+// unaffected by SetDefaultEncoder so that issued cursors stay valid. Using a
+// dedicated type keeps cursors from being confused with other IDs.
+type Cursor struct {
+	id uuid.UUID
+}
+
+// NewCursor creates a cursor positioned at u.
+func NewCursor(u uuid.UUID) Cursor {
+	return Cursor{id: u}
+}
+
+// ParseCursor reconstructs a cursor from its string form.
+// Returns an error if s is not a valid short UUID.
+func ParseCursor(s string) (Cursor, error) {
+	u, err := defaultEncoder.ExpandUUID(s)
+	if err != nil {
+		return Cursor{}, err
+	}
+
+	return Cursor{id: u}, nil
+}
+
+// String returns the cursor's short ID form.
+func (c Cursor) String() string {
+	// The base62 encoder has no checksum or reserved values, so it cannot fail
+	short, _ := defaultEncoder.ShortenUUID(c.id)
+	return short
+}
+
+// UUID returns the UUID the cursor is positioned at.
+func (c Cursor) UUID() uuid.UUID {
+	return c.id
+}
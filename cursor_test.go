@@ -0,0 +1,48 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestCursor(t *testing.T) {
+	testCases := []uuid.UUID{
+		uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"),
+		uuid.Nil,
+		uuid.New(),
+	}
+
+	for _, u := range testCases {
+		t.Run(u.String(), func(t *testing.T) {
+			cursor := NewCursor(u)
+
+			parsed, err := ParseCursor(cursor.String())
+			if err != nil {
+				t.Fatalf("Error parsing cursor %s: %v", cursor, err)
+			}
+
+			if parsed != cursor {
+				t.Errorf("Expected cursor %s, got %s", cursor, parsed)
+			}
+
+			if parsed.UUID() != u {
+				t.Errorf("Expected UUID %s, got %s", u, parsed.UUID())
+			}
+		})
+	}
+
+	if got := NewCursor(uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")).String(); got != "2XrVqpuNYMfp5OSuawGnL1" {
+		t.Errorf("Expected 2XrVqpuNYMfp5OSuawGnL1, got %s", got)
+	}
+}
+
+func TestParseCursorInvalid(t *testing.T) {
+	_, err := ParseCursor("not-a-cursor")
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+}
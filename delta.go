@@ -0,0 +1,49 @@
+package shortuuid
+
+import (
+	"math/big"
+
+	"github.com/google/uuid"
+)
+
+// ShortenUUIDDelta encodes target relative to base as the short ID of the
+// difference target - base, treating both UUIDs as 128-bit integers. For nearby
+// UUIDs, such as version 7 UUIDs minted close together in time, the token is
+// much shorter than a full short UUID.
+// Negative deltas are not supported: target must not precede base, otherwise
+// an error is returned. Sort the sequence first, or swap base and target.
+func ShortenUUIDDelta(base, target uuid.UUID) (string, error) {
+	delta := new(big.Int).SetBytes(target[:])
+	delta.Sub(delta, new(big.Int).SetBytes(base[:]))
+
+	if delta.Sign() < 0 {
+		return "", &EncodeError{
+			Input:  target.String(),
+			Reason: "target precedes base " + base.String() + ": negative deltas are not supported",
+		}
+	}
+
+	return defaultEncoder.intToBase(delta), nil
+}
+
+// ExpandUUIDDelta reconstructs the UUID encoded by ShortenUUIDDelta relative to base.
+// Returns an error if the short ID is invalid or base plus the delta exceeds 128 bits.
+func ExpandUUIDDelta(base uuid.UUID, shortID string) (uuid.UUID, error) {
+	delta, err := defaultEncoder.baseToInt(shortID)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	num := delta.Add(delta, new(big.Int).SetBytes(base[:]))
+	if num.Cmp(maxUUID) > 0 {
+		return uuid.UUID{}, &DecodeError{
+			ShortID: shortID,
+			Reason:  "delta from base " + base.String() + " exceeds 128 bits",
+			Code:    CodeOverflow,
+		}
+	}
+
+	var u uuid.UUID
+	num.FillBytes(u[:])
+	return u, nil
+}
@@ -0,0 +1,72 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenUUIDDelta(t *testing.T) {
+	testCases := []struct {
+		name      string
+		base      string
+		target    string
+		maxLength int
+	}{
+		{"identical", "01890a5d-ac96-774b-bcce-b302099a8057", "01890a5d-ac96-774b-bcce-b302099a8057", 1},
+		{"close", "01890a5d-ac96-774b-bcce-b302099a8057", "01890a5d-ac96-774b-bcce-b302099a8157", 2},
+		{"same millisecond", "01890a5d-ac96-774b-bcce-b302099a8057", "01890a5d-ac96-7f4b-bcce-b302099a8057", 13},
+		{"far", "00000000-0000-0000-0000-000000000000", "ffffffff-ffff-ffff-ffff-ffffffffffff", 22},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			base := uuid.MustParse(tc.base)
+			target := uuid.MustParse(tc.target)
+
+			delta, err := ShortenUUIDDelta(base, target)
+			if err != nil {
+				t.Fatalf("Error shortening delta: %v", err)
+			}
+
+			if len(delta) > tc.maxLength {
+				t.Errorf("Expected at most %d characters, got %d in %s", tc.maxLength, len(delta), delta)
+			}
+
+			expanded, err := ExpandUUIDDelta(base, delta)
+			if err != nil {
+				t.Fatalf("Error expanding delta %s: %v", delta, err)
+			}
+
+			if expanded != target {
+				t.Errorf("Expected %s, got %s", target, expanded)
+			}
+
+			t.Logf("%s -> %s: %s", base, target, delta)
+		})
+	}
+}
+
+func TestShortenUUIDDeltaErrors(t *testing.T) {
+	base := uuid.MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+	earlier := uuid.MustParse("01890a5d-ac96-774b-bcce-b302099a8056")
+
+	_, err := ShortenUUIDDelta(base, earlier)
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Errorf("Expected EncodeError for negative delta, got %T: %v", err, err)
+	}
+
+	// Adding to the max UUID overflows
+	_, err = ExpandUUIDDelta(uuid.Max, "1")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError for overflow, got %T: %v", err, err)
+	}
+
+	_, err = ExpandUUIDDelta(base, "@")
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError for invalid delta, got %T: %v", err, err)
+	}
+}
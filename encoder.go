@@ -0,0 +1,942 @@
+package shortuuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Encoder converts strings and UUIDs to short IDs using a specific alphabet.
+// The package-level functions use an Encoder with the default base62 alphabet.
+// An Encoder is safe for concurrent use.
+type Encoder struct {
+	alphabet  []rune
+	charset   string       // Description of the valid characters used in error messages
+	uuidWidth int          // Characters needed for any 128-bit value in this alphabet
+	intern    *internTable // Shared strings for repeated UUIDs, nil when disabled
+	ascii     *[128]int8   // Alphabet positions for ASCII-only alphabets, -1 for other characters
+	index     map[rune]int // Alphabet positions for alphabets with non-ASCII characters
+	shift     uint         // Bits per character for power-of-two alphabets up to 256 characters, 0 otherwise
+
+	allowEmpty bool // Decode the empty short ID as zero instead of failing
+	minBase    int  // Smallest alphabet size accepted by NewEncoder
+	rejectMax  bool // Refuse to decode the max UUID
+	pad        bool // Pad UUIDs to uuidWidth
+	strict     bool // Pad UUIDs to uuidWidth and reject shorter short IDs
+	descending bool // Complement UUIDs so short IDs sort in reverse order
+	homoglyphs bool // Map Cyrillic and Greek lookalikes to Latin when decoding
+	maxBits    int  // Largest decoded value in bits, 0 for no limit
+
+	checksum Checksummer // Appends and verifies a check character on UUIDs, nil when disabled
+
+	name string // Name recorded in errors produced by the encoder
+}
+
+// Option configures an Encoder created by NewEncoder.
+type Option func(*Encoder)
+
+// WithAllowEmpty restores the legacy behavior of decoding the empty short ID as
+// zero: Expand returns an empty string and ExpandUUID returns the nil UUID.
+// By default decoding the empty string fails, since it is almost always a bug.
+func WithAllowEmpty() Option {
+	return func(e *Encoder) {
+		e.allowEmpty = true
+	}
+}
+
+// WithMinBase makes NewEncoder reject alphabets with fewer than n characters.
+// Small alphabets make UUID short IDs impractically long (128 characters in
+// base2), so this guards against accidental misconfiguration.
+func WithMinBase(n int) Option {
+	return func(e *Encoder) {
+		e.minBase = n
+	}
+}
+
+// WithStrictWidth makes the encoder treat UUID short IDs as fixed width:
+// ShortenUUID left-pads its output with the first alphabet character to the
+// width needed for any 128-bit value (22 characters in base62), and ExpandUUID
+// rejects shorter input with a DecodeError wrapping ErrTruncated. This catches
+// short IDs cut off by length-limited fields, which would otherwise silently
+// decode to a different UUID.
+func WithStrictWidth() Option {
+	return func(e *Encoder) {
+		e.strict = true
+	}
+}
+
+// WithPadding makes ShortenUUID left-pad its output with the first alphabet
+// character to the width needed for any 128-bit value (22 characters in
+// base62). Unlike WithStrictWidth, ExpandUUID still accepts shorter input, so
+// IDs minted before padding was enabled keep decoding.
+func WithPadding() Option {
+	return func(e *Encoder) {
+		e.pad = true
+	}
+}
+
+// WithDescendingSort makes UUID short IDs sort in the reverse of the UUIDs'
+// natural order, for descending-order cursors. ShortenUUID encodes the bitwise
+// complement of the UUID (2^128 - 1 - u), left-padded to the fixed width needed
+// for any 128-bit value, so larger UUIDs produce lexically smaller short IDs;
+// ExpandUUID undoes the complement. The ordering holds for alphabets listed in
+// ascending byte order, such as the default base62 alphabet.
+func WithDescendingSort() Option {
+	return func(e *Encoder) {
+		e.descending = true
+	}
+}
+
+// WithMaxBits makes decoding fail as soon as the accumulated value exceeds n
+// bits, before the rest of the input is processed. It bounds the memory an
+// adversarial short ID can make Expand allocate more precisely than a limit on
+// its length. A non-positive n disables the limit.
+func WithMaxBits(n int) Option {
+	return func(e *Encoder) {
+		e.maxBits = n
+	}
+}
+
+// WithName sets a name that is recorded in the Encoder field of every
+// EncodeError and DecodeError the encoder produces, and included in their
+// messages, to tell failures apart when several encoders are in use.
+func WithName(name string) Option {
+	return func(e *Encoder) {
+		e.name = name
+	}
+}
+
+// WithRejectMaxUUID makes ExpandUUID return a *DecodeError for the max UUID
+// (ffffffff-ffff-ffff-ffff-ffffffffffff), which is commonly a "not set" or
+// "infinity" sentinel that should not arrive as user input.
+func WithRejectMaxUUID() Option {
+	return func(e *Encoder) {
+		e.rejectMax = true
+	}
+}
+
+// NewEncoder creates an Encoder that uses the given alphabet.
+// The alphabet must contain at least two characters and no duplicates.
+// Returns an *EncodeError if the alphabet is invalid.
+func NewEncoder(alphabet string, opts ...Option) (*Encoder, error) {
+	runes := []rune(alphabet)
+	if len(runes) < 2 {
+		return nil, &EncodeError{
+			Input:  alphabet,
+			Reason: "alphabet must contain at least two characters",
+		}
+	}
+
+	seen := make(map[rune]bool, len(runes))
+	for _, char := range runes {
+		if seen[char] {
+			return nil, &EncodeError{
+				Input:  alphabet,
+				Reason: fmt.Sprintf("alphabet contains duplicate character '%c'", char),
+			}
+		}
+		seen[char] = true
+	}
+
+	e := newEncoder(runes, alphabet)
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	if len(runes) < e.minBase {
+		return nil, &EncodeError{
+			Input: alphabet,
+			Reason: fmt.Sprintf("alphabet has %d characters, below the minimum base %d (UUIDs would encode to %d characters)",
+				len(runes), e.minBase, digitsFor(128, len(runes))),
+			Encoder: e.name,
+		}
+	}
+
+	return e, nil
+}
+
+// newEncoder creates an Encoder for a vetted built-in alphabet
+func newEncoder(alphabet []rune, charset string) *Encoder {
+	ascii, index := buildIndex(alphabet)
+	e := &Encoder{
+		alphabet:  alphabet,
+		charset:   charset,
+		uuidWidth: digitsFor(128, len(alphabet)),
+		ascii:     ascii,
+		index:     index,
+	}
+
+	// Power-of-two bases convert with shifts and masks instead of division
+	if n := len(alphabet); n <= 256 && n&(n-1) == 0 {
+		e.shift = uint(bits.TrailingZeros(uint(n)))
+	}
+	return e
+}
+
+// buildIndex precomputes the reverse lookup for an alphabet: a flat array when
+// every character is ASCII, which avoids hashing on the decode hot path, and
+// a map otherwise. Exactly one of the results is non-nil.
+func buildIndex(alphabet []rune) (*[128]int8, map[rune]int) {
+	for _, char := range alphabet {
+		if char >= utf8.RuneSelf {
+			index := make(map[rune]int, len(alphabet))
+			for i, char := range alphabet {
+				index[char] = i
+			}
+			return nil, index
+		}
+	}
+
+	// An ASCII alphabet has at most 128 characters, so positions fit in int8
+	var ascii [128]int8
+	for i := range ascii {
+		ascii[i] = -1
+	}
+	for i, char := range alphabet {
+		ascii[char] = int8(i)
+	}
+	return &ascii, nil
+}
+
+// NoLookalikes encodes with a 54-character alphabet that drops the visually
+// ambiguous characters 0, O, o, Q, 1, I, i and l, for IDs that are printed or
+// read from signage. Its output is slightly longer than base62: up to 23
+// characters for a UUID instead of 22.
+var NoLookalikes = newEncoder(
+	[]rune("23456789ABCDEFGHJKLMNPRSTUVWXYZabcdefghjkmnpqrstuvwxyz"),
+	"2-9, A-Z, a-z except I, O, Q, i, l, o",
+)
+
+// QRAlphanumeric encodes with the 36 characters 0-9 and A-Z, which QR codes
+// store efficiently in their alphanumeric mode. The mode's extra symbols
+// (space, $, %, *, +, -, ., /, :) are left out because they are unsafe in URLs
+// and file names. Output is longer than base62, up to 25 characters for a
+// UUID, but packs into fewer QR modules than a mixed-case ID.
+var QRAlphanumeric = newEncoder(
+	[]rune("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+	"0-9, A-Z",
+)
+
+// DNSLabel encodes with the 36 characters 0-9 and a-z, so short IDs can be
+// used as DNS labels such as subdomains. Labels allow only a-z, 0-9 and '-',
+// must not start or end with '-', and are limited to 63 characters; the
+// alphabet has no '-' at all and a UUID encodes to at most 25 characters, so
+// every output is a valid label. DNS is case-insensitive and resolvers may
+// change case, so lowercase a label before decoding it.
+var DNSLabel = newEncoder(
+	[]rune("0123456789abcdefghijklmnopqrstuvwxyz"),
+	"0-9, a-z",
+)
+
+// FilenameSafe encodes with the 36 characters 0-9 and a-z, so short UUIDs can
+// be used as file names on every major operating system. With no uppercase
+// letters, IDs cannot collide on case-insensitive file systems such as the
+// macOS and Windows defaults, and the alphabet avoids every character reserved
+// on Windows (< > : " / \ | ? *), leading or trailing dots and spaces, and
+// control characters. UUIDs are padded to a fixed 25 characters, as with
+// WithStrictWidth, so they never spell a reserved device name like CON or NUL.
+// Shorten output is not padded, since padding would change what Expand
+// returns, so a short input can spell one: Shorten("@7") is "con". Add a
+// prefix before using Shorten output as a file name.
+var FilenameSafe = func() *Encoder {
+	e := newEncoder([]rune("0123456789abcdefghijklmnopqrstuvwxyz"), "0-9, a-z")
+	e.strict = true
+	return e
+}()
+
+// NanoIDAlphabet encodes with the 64 URL-safe characters A-Z, a-z, 0-9, '_'
+// and '-' used by NanoID, so short UUIDs fit systems that validate NanoID
+// character sets. None of the characters need escaping in URLs. A UUID encodes
+// to at most 22 characters; this is an integer encoding, not NanoID's random
+// generation or RFC 4648 base64url, so the IDs are not interchangeable with those.
+var NanoIDAlphabet = newEncoder(
+	[]rune("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"),
+	"A-Z, a-z, 0-9, _, -",
+)
+
+// FlickrBase58 matches the default flickrBase58 translator of the short-uuid
+// npm package, so IDs generated in the browser decode on the server and vice
+// versa. The alphabet is 1-9, a-z and A-Z without 0, O, I and l, in that
+// order. Like short-uuid with consistentLength, UUIDs are converted big-endian
+// and left-padded with '1' (the zero digit) to 22 characters; ExpandUUID also
+// accepts the unpadded IDs short-uuid produces without consistentLength.
+// Shorten and Expand have no short-uuid counterpart.
+var FlickrBase58 = func() *Encoder {
+	e := newEncoder(
+		[]rune("123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"),
+		"1-9, a-z, A-Z except I, O, l",
+	)
+	e.pad = true
+	return e
+}()
+
+// Shorten converts any string to a short ID using the encoder alphabet.
+// Returns an error if the input string is empty.
+func (e *Encoder) Shorten(input string) (string, error) {
+	short, err := e.encodeString(input)
+	return short, e.named(err)
+}
+
+// Expand converts a short ID back to the original string.
+// Returns an error if the short ID contains characters outside the encoder alphabet.
+func (e *Encoder) Expand(shortID string) (string, error) {
+	expanded, err := e.decodeString(shortID)
+	return expanded, e.named(err)
+}
+
+// ShortenUUID converts a uuid.UUID to a short ID using the encoder alphabet.
+func (e *Encoder) ShortenUUID(u uuid.UUID) (string, error) {
+	if e.intern != nil {
+		if short, ok := e.intern.lookup(u); ok {
+			return short, nil
+		}
+	}
+
+	short, err := e.shortenUUID(u, false)
+	if err != nil {
+		return "", e.named(err)
+	}
+
+	if e.intern != nil {
+		short = e.intern.store(u, short)
+	}
+
+	return short, nil
+}
+
+// shortenUUID encodes a UUID without consulting the intern table, padding
+// it to the full UUID width when pad is set or the encoder requires it.
+// Padding comes before the check character, which is never counted in the width.
+func (e *Encoder) shortenUUID(u uuid.UUID, pad bool) (string, error) {
+	value := u
+	if e.descending {
+		value = complementUUID(u)
+	}
+
+	short := e.encode128(value)
+	if pad || e.pad || e.strict || e.descending {
+		short = e.padLeft(short, e.uuidWidth)
+	}
+
+	if e.checksum != nil {
+		// Only this copy escapes to the checksum, keeping u on the stack otherwise
+		data := u
+		check := int(e.checksum.Compute(data[:]))
+		if check >= len(e.alphabet) {
+			return "", &EncodeError{
+				Input:  u.String(),
+				Reason: fmt.Sprintf("checksum value %d is outside the %d-character alphabet", check, len(e.alphabet)),
+			}
+		}
+		short += string(e.alphabet[check])
+	}
+	return short, nil
+}
+
+// ExpandUUID converts a short ID back to a uuid.UUID object.
+// Returns an error if the short ID is invalid or doesn't decode to a valid UUID.
+func (e *Encoder) ExpandUUID(shortID string) (uuid.UUID, error) {
+	u, err := e.expandUUID(shortID)
+	return u, e.named(err)
+}
+
+// expandUUID decodes a short ID to a UUID without naming errors, verifying
+// the check character when a checksum is configured
+func (e *Encoder) expandUUID(shortID string) (uuid.UUID, error) {
+	if e.checksum == nil {
+		return e.decodeUUID(shortID)
+	}
+
+	runes := []rune(shortID)
+	if len(runes) < 2 {
+		return uuid.UUID{}, &DecodeError{
+			ShortID: shortID,
+			Reason:  "short ID is too short to carry a check character",
+			Err:     ErrTruncated,
+			Code:    CodeBadLength,
+		}
+	}
+
+	check, ok := e.alphabetIndex(runes[len(runes)-1])
+	if !ok {
+		return uuid.UUID{}, e.invalidCharError(shortID, runes[len(runes)-1], len(runes))
+	}
+
+	u, err := e.decodeUUID(string(runes[:len(runes)-1]))
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if check > 255 || !e.checksum.Verify(u[:], byte(check)) {
+		return uuid.UUID{}, &DecodeError{
+			ShortID: shortID,
+			Reason:  "check character does not match",
+			Err:     ErrChecksumMismatch,
+			Code:    CodeChecksum,
+		}
+	}
+
+	return u, nil
+}
+
+// decodeUUID decodes a short ID without a check character to a UUID
+func (e *Encoder) decodeUUID(shortID string) (uuid.UUID, error) {
+	if e.strict {
+		if n := utf8.RuneCountInString(shortID); n < e.uuidWidth {
+			return uuid.UUID{}, &DecodeError{
+				ShortID: shortID,
+				Reason:  fmt.Sprintf("short ID has %d characters, expected %d", n, e.uuidWidth),
+				Err:     ErrTruncated,
+				Code:    CodeBadLength,
+			}
+		}
+	}
+
+	var parsedUUID uuid.UUID
+	if err := e.decode128(shortID, (*[16]byte)(&parsedUUID)); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	if e.descending {
+		parsedUUID = complementUUID(parsedUUID)
+	}
+
+	if e.rejectMax && parsedUUID == uuid.Max {
+		return uuid.UUID{}, &DecodeError{
+			ShortID: shortID,
+			Reason:  "decoded to the max UUID, which is reserved",
+			Code:    CodeReserved,
+		}
+	}
+
+	return parsedUUID, nil
+}
+
+// VerifyUUIDBijection round-trips the nil UUID, the max UUID, and samples
+// random UUIDs through the encoder and returns an error on the first
+// mismatch. Callers with custom alphabets can run it at startup as a self-test.
+func (e *Encoder) VerifyUUIDBijection(samples int) error {
+	candidates := []uuid.UUID{uuid.Nil, uuid.Max}
+	for i := 0; i < samples; i++ {
+		candidates = append(candidates, uuid.New())
+	}
+
+	for _, u := range candidates {
+		short, err := e.ShortenUUID(u)
+		if err != nil {
+			return err
+		}
+
+		expanded, err := e.ExpandUUID(short)
+		if err != nil {
+			return err
+		}
+
+		if expanded != u {
+			return e.named(&DecodeError{
+				ShortID: short,
+				Reason:  fmt.Sprintf("round trip mismatch: expected %s, got %s", u, expanded),
+				Code:    CodeRoundTrip,
+			})
+		}
+	}
+
+	return nil
+}
+
+// CompareEncoders shortens the nil UUID, the max UUID, and samples random
+// UUIDs with both encoders and reports whether their outputs ever differ. When
+// they do, example describes the first differing UUID and both short IDs. Use
+// it in CI to confirm that a refactored encoder still matches the original, or
+// that two supposedly different encoders really are distinct.
+func CompareEncoders(a, b *Encoder, samples int) (differs bool, example string) {
+	candidates := []uuid.UUID{uuid.Nil, uuid.Max}
+	for i := 0; i < samples; i++ {
+		candidates = append(candidates, uuid.New())
+	}
+
+	for _, u := range candidates {
+		shortA, errA := a.ShortenUUID(u)
+		shortB, errB := b.ShortenUUID(u)
+		if shortA != shortB || (errA == nil) != (errB == nil) {
+			return true, fmt.Sprintf("%s: %q vs %q", u, shortA, shortB)
+		}
+	}
+
+	return false, ""
+}
+
+// Transcode converts a short ID from one encoder's alphabet to another's by
+// decoding it to its integer value with from and encoding that value with to.
+// The value is preserved exactly, so the result expands under to just as the
+// input expanded under from; options that pad UUIDs to a fixed width are not
+// applied. Returns an error if shortID is not valid for from.
+func Transcode(shortID string, from, to *Encoder) (string, error) {
+	num, err := from.baseToInt(shortID)
+	if err != nil {
+		return "", from.named(err)
+	}
+
+	return to.intToBase(num), nil
+}
+
+// named records the encoder name in err if it is an EncodeError or DecodeError
+func (e *Encoder) named(err error) error {
+	if err == nil || e.name == "" {
+		return err
+	}
+
+	var encodeErr *EncodeError
+	if errors.As(err, &encodeErr) {
+		encodeErr.Encoder = e.name
+	}
+
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		decodeErr.Encoder = e.name
+	}
+
+	return err
+}
+
+// encodeString converts any string to a short ID using the encoder alphabet
+func (e *Encoder) encodeString(input string) (string, error) {
+	if input == "" {
+		return "", &EncodeError{
+			Input:  input,
+			Reason: "input string cannot be empty",
+		}
+	}
+
+	return e.encodeNonEmpty(input), nil
+}
+
+// encodeNonEmpty converts a string known to be non-empty to a short ID.
+// Each leading zero byte becomes a leading zero glyph, as in base58, since the
+// integer value alone cannot represent them; other inputs encode to their
+// plain integer value.
+func (e *Encoder) encodeNonEmpty(input string) string {
+	zeros := len(input) - len(strings.TrimLeft(input, "\x00"))
+	prefix := strings.Repeat(string(e.alphabet[0]), zeros)
+	if zeros == len(input) {
+		return prefix
+	}
+
+	// Convert string to bytes, then to big integer
+	bytes := []byte(input[zeros:])
+	num := new(big.Int)
+	num.SetBytes(bytes)
+
+	// Convert to the target base
+	return prefix + e.intToBase(num)
+}
+
+// decodeString converts a short ID back to the original string
+func (e *Encoder) decodeString(shortID string) (string, error) {
+	bytes, err := e.appendDecoded(nil, shortID)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes), nil
+}
+
+// appendDecoded appends the bytes a short ID decodes to onto dst
+func (e *Encoder) appendDecoded(dst []byte, shortID string) ([]byte, error) {
+	// Convert from base to integer
+	num, err := e.baseToInt(shortID)
+	if err != nil {
+		return dst, err
+	}
+
+	return e.appendValue(dst, num, shortID), nil
+}
+
+// appendValue appends the bytes that the decoded value num of shortID stands
+// for onto dst: a zero byte for each leading zero glyph, then num big-endian
+func (e *Encoder) appendValue(dst []byte, num *big.Int, shortID string) []byte {
+	zeros := 0
+	for _, char := range shortID {
+		if char != e.alphabet[0] {
+			break
+		}
+		zeros++
+	}
+
+	// FillBytes right-aligns the value and zeroes the prefix
+	size := (num.BitLen() + 7) / 8
+	n := len(dst)
+	dst = slices.Grow(dst, zeros+size)[:n+zeros+size]
+	num.FillBytes(dst[n:])
+	return dst
+}
+
+// encode128 converts a 16-byte big-endian value to a short ID using
+// fixed-width division on two uint64 halves, matching encodeBytes without
+// allocating anything but the result
+func (e *Encoder) encode128(value [16]byte) string {
+	hi := binary.BigEndian.Uint64(value[:8])
+	lo := binary.BigEndian.Uint64(value[8:])
+	base := uint64(len(e.alphabet))
+
+	// ASCII alphabets build the digits as bytes, so the result string is the
+	// only allocation
+	if e.ascii != nil {
+		var digits [128]byte
+		i := len(digits)
+		for {
+			var remainder uint64
+			hi, remainder = hi/base, hi%base
+			lo, remainder = bits.Div64(remainder, lo, base)
+			i--
+			digits[i] = byte(e.alphabet[remainder])
+			if hi == 0 && lo == 0 {
+				return string(digits[i:])
+			}
+		}
+	}
+
+	if hi == 0 && lo == 0 {
+		return string(e.alphabet[0])
+	}
+
+	// A 128-bit value needs at most 128 digits, in base 2
+	var digits [128]rune
+	i := len(digits)
+	for hi != 0 || lo != 0 {
+		var remainder uint64
+		hi, remainder = hi/base, hi%base
+		lo, remainder = bits.Div64(remainder, lo, base)
+		i--
+		digits[i] = e.alphabet[remainder]
+	}
+
+	return string(digits[i:])
+}
+
+// encodeBytes converts a big-endian byte slice to a short ID using the encoder alphabet
+func (e *Encoder) encodeBytes(b []byte) string {
+	return e.intToBase(new(big.Int).SetBytes(b))
+}
+
+// decodeFixed converts a short ID back to exactly size big-endian bytes
+func (e *Encoder) decodeFixed(shortID string, size int) ([]byte, error) {
+	num, err := e.baseToInt(shortID)
+	if err != nil {
+		return nil, err
+	}
+
+	if num.BitLen() > size*8 {
+		return nil, &DecodeError{
+			ShortID: shortID,
+			Reason:  fmt.Sprintf("decoded value exceeds %d bytes", size),
+			Code:    CodeOverflow,
+		}
+	}
+
+	return num.FillBytes(make([]byte, size)), nil
+}
+
+// digitsFor returns the number of characters needed to represent any value of
+// the given bit size in the given base
+func digitsFor(bits, base int) int {
+	n := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	n.Sub(n, big.NewInt(1))
+
+	b := big.NewInt(int64(base))
+	digits := 0
+	for n.Sign() > 0 {
+		n.Quo(n, b)
+		digits++
+	}
+	return digits
+}
+
+// complementUUID flips every bit of u, reversing the order of UUIDs
+func complementUUID(u uuid.UUID) uuid.UUID {
+	for i := range u {
+		u[i] = ^u[i]
+	}
+	return u
+}
+
+// padLeft pads a short ID with leading zero glyphs to width characters
+func (e *Encoder) padLeft(short string, width int) string {
+	n := utf8.RuneCountInString(short)
+	if n >= width {
+		return short
+	}
+
+	return strings.Repeat(string(e.alphabet[0]), width-n) + short
+}
+
+// intToBase converts a big integer to the target base representation.
+// The output is canonical: it never starts with the zero glyph (the first
+// alphabet character) unless num is exactly zero, which encodes to that glyph
+// alone. Canonicalize and ExpandVerbose rely on this.
+func (e *Encoder) intToBase(num *big.Int) string {
+	if num.Sign() == 0 {
+		return string(e.alphabet[0])
+	}
+
+	if e.shift > 0 {
+		return e.intToBasePow2(num)
+	}
+
+	var result []rune
+	base := big.NewInt(int64(len(e.alphabet)))
+	zero := big.NewInt(0)
+
+	// Make a copy to avoid modifying the original
+	n := new(big.Int).Set(num)
+
+	for n.Cmp(zero) > 0 {
+		remainder := new(big.Int)
+		n.DivMod(n, base, remainder)
+		result = append([]rune{e.alphabet[remainder.Int64()]}, result...)
+	}
+
+	return string(result)
+}
+
+// intToBasePow2 converts a positive big integer to a power-of-two base by
+// slicing its bits into shift-sized groups, producing the same output as the
+// division in intToBase
+func (e *Encoder) intToBasePow2(num *big.Int) string {
+	b := num.Bytes()
+	mask := uint(1)<<e.shift - 1
+	result := make([]rune, 0, (len(b)*8+int(e.shift)-1)/int(e.shift))
+
+	// Emit digits from the least significant end
+	var acc, accBits uint
+	for i := len(b) - 1; i >= 0; i-- {
+		acc |= uint(b[i]) << accBits
+		accBits += 8
+		for accBits >= e.shift {
+			result = append(result, e.alphabet[acc&mask])
+			acc >>= e.shift
+			accBits -= e.shift
+		}
+	}
+	if accBits > 0 {
+		result = append(result, e.alphabet[acc])
+	}
+
+	// Drop zero digits above the most significant bit to stay canonical
+	for len(result) > 1 && result[len(result)-1] == e.alphabet[0] {
+		result = result[:len(result)-1]
+	}
+
+	slices.Reverse(result)
+	return string(result)
+}
+
+// baseToInt converts a base representation back to a big integer
+func (e *Encoder) baseToInt(encoded string) (*big.Int, error) {
+	if encoded == "" && !e.allowEmpty {
+		return nil, &DecodeError{
+			ShortID: encoded,
+			Reason:  "short ID cannot be empty",
+			Code:    CodeEmpty,
+		}
+	}
+
+	if e.shift > 0 {
+		return e.baseToIntPow2(encoded)
+	}
+
+	result := big.NewInt(0)
+	base := big.NewInt(int64(len(e.alphabet)))
+
+	position := 0
+	for _, char := range encoded {
+		position++
+		index, ok := e.alphabetIndex(char)
+		if !ok {
+			return nil, e.invalidCharError(encoded, char, position)
+		}
+
+		result.Mul(result, base)
+		result.Add(result, big.NewInt(int64(index)))
+
+		if e.maxBits > 0 && result.BitLen() > e.maxBits {
+			return nil, e.maxBitsError(encoded)
+		}
+	}
+
+	return result, nil
+}
+
+// baseToIntPow2 converts a power-of-two base representation back to a big
+// integer by packing shift bits per character, with the same errors as the
+// multiplication in baseToInt
+func (e *Encoder) baseToIntPow2(encoded string) (*big.Int, error) {
+	digits := make([]byte, 0, len(encoded))
+	bitLen := 0
+
+	position := 0
+	for _, char := range encoded {
+		position++
+		index, ok := e.alphabetIndex(char)
+		if !ok {
+			return nil, e.invalidCharError(encoded, char, position)
+		}
+
+		if bitLen > 0 {
+			bitLen += int(e.shift)
+		} else {
+			bitLen = bits.Len(uint(index))
+		}
+
+		if e.maxBits > 0 && bitLen > e.maxBits {
+			return nil, e.maxBitsError(encoded)
+		}
+
+		digits = append(digits, byte(index))
+	}
+
+	// Pack the digits into big-endian bytes, starting from the least significant
+	packed := make([]byte, (len(digits)*int(e.shift)+7)/8)
+	end := len(packed)
+
+	var acc, accBits uint
+	for i := len(digits) - 1; i >= 0; i-- {
+		acc |= uint(digits[i]) << accBits
+		accBits += e.shift
+		for accBits >= 8 {
+			end--
+			packed[end] = byte(acc)
+			acc >>= 8
+			accBits -= 8
+		}
+	}
+	if accBits > 0 {
+		end--
+		packed[end] = byte(acc)
+	}
+
+	return new(big.Int).SetBytes(packed[end:]), nil
+}
+
+// decode128 converts a short ID to a 128-bit big-endian value in dst using
+// fixed-width arithmetic on two uint64 halves, without allocating on success.
+// dst is only written when decoding succeeds.
+func (e *Encoder) decode128(encoded string, dst *[16]byte) error {
+	if encoded == "" && !e.allowEmpty {
+		return &DecodeError{
+			ShortID: encoded,
+			Reason:  "short ID cannot be empty",
+			Code:    CodeEmpty,
+		}
+	}
+
+	base := uint64(len(e.alphabet))
+	var hi, lo uint64
+	overflowed := false
+
+	position := 0
+	for _, char := range encoded {
+		position++
+		index, ok := e.alphabetIndex(char)
+		if !ok {
+			return e.invalidCharError(encoded, char, position)
+		}
+
+		// Keep validating characters after an overflow so that invalid
+		// characters are reported first, as by baseToInt
+		if overflowed {
+			continue
+		}
+
+		// (hi, lo) = (hi, lo) * base + index, failing on any carry out of hi
+		overflow, hiProduct := bits.Mul64(hi, base)
+		loCarry, loProduct := bits.Mul64(lo, base)
+
+		var carry uint64
+		hi, carry = bits.Add64(hiProduct, loCarry, 0)
+		overflow |= carry
+		lo, carry = bits.Add64(loProduct, uint64(index), 0)
+		hi, carry = bits.Add64(hi, 0, carry)
+		overflow |= carry
+		overflowed = overflow != 0
+
+		if !overflowed && e.maxBits > 0 && bitLen128(hi, lo) > e.maxBits {
+			return e.maxBitsError(encoded)
+		}
+	}
+
+	if overflowed {
+		return &DecodeError{
+			ShortID: encoded,
+			Reason:  "decoded value exceeds 16 bytes",
+			Err:     ErrUUIDOverflow,
+			Code:    CodeOverflow,
+		}
+	}
+
+	binary.BigEndian.PutUint64(dst[:8], hi)
+	binary.BigEndian.PutUint64(dst[8:], lo)
+	return nil
+}
+
+// bitLen128 returns the bit length of the 128-bit value (hi, lo)
+func bitLen128(hi, lo uint64) int {
+	if hi != 0 {
+		return 64 + bits.Len64(hi)
+	}
+	return bits.Len64(lo)
+}
+
+// maxBitsError reports a decoded value larger than the WithMaxBits limit
+func (e *Encoder) maxBitsError(encoded string) error {
+	return &DecodeError{
+		ShortID: encoded,
+		Reason:  fmt.Sprintf("decoded value exceeds %d bits", e.maxBits),
+		Code:    CodeOverflow,
+	}
+}
+
+// invalidCharError reports a character outside the encoder alphabet at the
+// given 1-based rune position
+func (e *Encoder) invalidCharError(encoded string, char rune, position int) error {
+	return &DecodeError{
+		ShortID:  encoded,
+		Reason:   fmt.Sprintf("invalid character '%c' in short ID (valid characters: %s)", char, e.charset),
+		Position: position,
+		Code:     CodeInvalidChar,
+	}
+}
+
+// alphabetIndex returns the position of char in the encoder alphabet,
+// normalizing homoglyphs when the encoder is configured to
+func (e *Encoder) alphabetIndex(char rune) (int, bool) {
+	index, ok := e.lookup(char)
+	if !ok && e.homoglyphs {
+		if latin, found := homoglyphs[char]; found {
+			return e.lookup(latin)
+		}
+	}
+	return index, ok
+}
+
+// lookup returns the position of char in the encoder alphabet, using the
+// ASCII table or map chosen by buildIndex
+func (e *Encoder) lookup(char rune) (int, bool) {
+	if e.ascii != nil {
+		if char < 0 || char >= utf8.RuneSelf {
+			return 0, false
+		}
+		index := e.ascii[char]
+		return int(index), index >= 0
+	}
+
+	index, ok := e.index[char]
+	return index, ok
+}
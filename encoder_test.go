@@ -0,0 +1,1027 @@
+package shortuuid
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNoLookalikes(t *testing.T) {
+	if len(NoLookalikes.alphabet) != 54 {
+		t.Fatalf("Expected 54-character alphabet, got %d", len(NoLookalikes.alphabet))
+	}
+
+	excluded := "0Oo1IliQ"
+
+	for i := 0; i < 100; i++ {
+		testUUID := uuid.New()
+
+		short, err := NoLookalikes.ShortenUUID(testUUID)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", testUUID, err)
+		}
+
+		if strings.ContainsAny(short, excluded) {
+			t.Errorf("Short ID %s contains an excluded character", short)
+		}
+
+		if len(short) > 23 {
+			t.Errorf("Expected at most 23 characters, got %d in %s", len(short), short)
+		}
+
+		expanded, err := NoLookalikes.ExpandUUID(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != testUUID {
+			t.Errorf("Expected %s, got %s", testUUID, expanded)
+		}
+	}
+}
+
+func TestNoLookalikesStrings(t *testing.T) {
+	testStrings := []string{
+		"hello world",
+		"unicode: 你好世界",
+		"https://example.com/path?query=value",
+	}
+
+	for _, input := range testStrings {
+		t.Run(input, func(t *testing.T) {
+			short, err := NoLookalikes.Shorten(input)
+			if err != nil {
+				t.Fatalf("Error shortening string '%s': %v", input, err)
+			}
+
+			if strings.ContainsAny(short, "0Oo1IliQ") {
+				t.Errorf("Short ID %s contains an excluded character", short)
+			}
+
+			expanded, err := NoLookalikes.Expand(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID '%s': %v", short, err)
+			}
+
+			if expanded != input {
+				t.Errorf("Expected '%s', got '%s'", input, expanded)
+			}
+		})
+	}
+}
+
+func TestNoLookalikesRejectsExcluded(t *testing.T) {
+	_, err := NoLookalikes.Expand("abc0")
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+
+	expectedReason := "invalid character '0' in short ID (valid characters: 2-9, A-Z, a-z except I, O, Q, i, l, o)"
+	if decodeErr.Reason != expectedReason {
+		t.Errorf("Expected reason %q, got %q", expectedReason, decodeErr.Reason)
+	}
+}
+
+func TestQRAlphanumeric(t *testing.T) {
+	// Characters allowed by the QR alphanumeric mode
+	const qrCharset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+	for i := 0; i < 100; i++ {
+		testUUID := uuid.New()
+
+		short, err := QRAlphanumeric.ShortenUUID(testUUID)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", testUUID, err)
+		}
+
+		for _, char := range short {
+			if !strings.ContainsRune(qrCharset, char) {
+				t.Fatalf("Short ID %s contains non-QR character '%c'", short, char)
+			}
+		}
+
+		if len(short) > 25 {
+			t.Errorf("Expected at most 25 characters, got %d in %s", len(short), short)
+		}
+
+		expanded, err := QRAlphanumeric.ExpandUUID(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != testUUID {
+			t.Errorf("Expected %s, got %s", testUUID, expanded)
+		}
+	}
+
+	// Lowercase is not part of the QR alphanumeric set
+	if _, err := QRAlphanumeric.Expand("abc"); err == nil {
+		t.Error("Expected error for lowercase input")
+	}
+}
+
+func TestDNSLabel(t *testing.T) {
+	// RFC 1123 host name label
+	label := regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+	for _, testUUID := range append([]uuid.UUID{uuid.Nil, uuid.Max}, uuid.New(), uuid.New(), uuid.New()) {
+		short, err := DNSLabel.ShortenUUID(testUUID)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", testUUID, err)
+		}
+
+		if !label.MatchString(short) {
+			t.Errorf("Short ID %s is not a valid DNS label", short)
+		}
+
+		if len(short) > 25 {
+			t.Errorf("Expected at most 25 characters, got %d in %s", len(short), short)
+		}
+
+		expanded, err := DNSLabel.ExpandUUID(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != testUUID {
+			t.Errorf("Expected %s, got %s", testUUID, expanded)
+		}
+	}
+
+	short, err := DNSLabel.ShortenUUID(uuid.Max)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != "f5lxx1zz5pnorynqglhzmsp33" {
+		t.Errorf("Expected f5lxx1zz5pnorynqglhzmsp33, got %s", short)
+	}
+
+	// Uppercase is not part of the alphabet
+	if _, err := DNSLabel.ExpandUUID(strings.ToUpper(short)); err == nil {
+		t.Error("Expected error for uppercase input")
+	}
+}
+
+func TestFilenameSafe(t *testing.T) {
+	for _, testUUID := range []uuid.UUID{uuid.Nil, uuid.Max, uuid.New(), uuid.New(), uuid.New()} {
+		short, err := FilenameSafe.ShortenUUID(testUUID)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", testUUID, err)
+		}
+
+		if strings.ToLower(short) != short {
+			t.Errorf("Short ID %s contains uppercase characters", short)
+		}
+
+		if strings.ContainsAny(short, `<>:"/\|?*. `) {
+			t.Errorf("Short ID %s contains characters reserved in file names", short)
+		}
+
+		if len(short) != 25 {
+			t.Errorf("Expected 25 characters, got %d in %s", len(short), short)
+		}
+
+		expanded, err := FilenameSafe.ExpandUUID(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != testUUID {
+			t.Errorf("Expected %s, got %s", testUUID, expanded)
+		}
+	}
+
+	// Windows device names are case-insensitive, so "con" would be reserved
+	short, err := FilenameSafe.ShortenUUID(uuid.MustParse("00000000-0000-0000-0000-000000004037"))
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != "0000000000000000000000con" {
+		t.Errorf("Expected padded 0000000000000000000000con, got %s", short)
+	}
+}
+
+func TestFilenameSafeReservedNames(t *testing.T) {
+	reserved := []string{"con", "prn", "aux", "nul"}
+	for i := 1; i <= 9; i++ {
+		reserved = append(reserved, fmt.Sprintf("com%d", i), fmt.Sprintf("lpt%d", i))
+	}
+
+	for _, name := range reserved {
+		num, err := FilenameSafe.baseToInt(name)
+		if err != nil {
+			t.Fatalf("Error decoding %s: %v", name, err)
+		}
+
+		var u uuid.UUID
+		num.FillBytes(u[:])
+		short, err := FilenameSafe.ShortenUUID(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", u, err)
+		}
+
+		if len(short) != 25 || !strings.HasSuffix(short, name) {
+			t.Errorf("Expected %s padded to 25 characters, got %s", name, short)
+		}
+	}
+
+	// Shorten output is not padded, as documented
+	short, err := FilenameSafe.Shorten("@7")
+	if err != nil {
+		t.Fatalf("Error shortening: %v", err)
+	}
+
+	if short != "con" {
+		t.Errorf("Expected con, got %s", short)
+	}
+}
+
+func TestNanoIDAlphabet(t *testing.T) {
+	nanoID := regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+	for _, testUUID := range []uuid.UUID{uuid.Nil, uuid.Max, uuid.New(), uuid.New(), uuid.New()} {
+		short, err := NanoIDAlphabet.ShortenUUID(testUUID)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", testUUID, err)
+		}
+
+		if !nanoID.MatchString(short) {
+			t.Errorf("Short ID %s contains non-NanoID characters", short)
+		}
+
+		if escaped := url.PathEscape(short); escaped != short {
+			t.Errorf("Expected %s to be URL-safe, got %s", short, escaped)
+		}
+
+		if len(short) > 22 {
+			t.Errorf("Expected at most 22 characters, got %d in %s", len(short), short)
+		}
+
+		expanded, err := NanoIDAlphabet.ExpandUUID(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != testUUID {
+			t.Errorf("Expected %s, got %s", testUUID, expanded)
+		}
+	}
+
+	short, err := NanoIDAlphabet.ShortenUUID(uuid.Max)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != "D---------------------" {
+		t.Errorf("Expected D---------------------, got %s", short)
+	}
+}
+
+func TestFlickrBase58(t *testing.T) {
+	// Vectors for short-uuid's default translator: the hex UUID converted to
+	// flickrBase58 and padded to 22 characters, as with consistentLength
+	testCases := map[string]string{
+		"00000000-0000-0000-0000-000000000000": "1111111111111111111111",
+		"00000000-0000-0000-0000-000000000001": "1111111111111111111112",
+		"08f057f3-23e0-4b2a-8703-03f2dab8f628": "272cRNggbLVb9VpdZySs3u",
+		"3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d": "8irRaQtTZMaL4JSYEohGVK",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff": "xBuEXKpA6iqZQK5Kf2TnkV",
+	}
+
+	for input, want := range testCases {
+		t.Run(input, func(t *testing.T) {
+			u := uuid.MustParse(input)
+
+			short, err := FlickrBase58.ShortenUUID(u)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			if short != want {
+				t.Errorf("Expected %s, got %s", want, short)
+			}
+
+			expanded, err := FlickrBase58.ExpandUUID(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != u {
+				t.Errorf("Expected %s, got %s", u, expanded)
+			}
+		})
+	}
+
+	// IDs from short-uuid without consistentLength are unpadded
+	expanded, err := FlickrBase58.ExpandUUID("2")
+	if err != nil {
+		t.Fatalf("Error expanding unpadded short ID: %v", err)
+	}
+
+	if want := uuid.MustParse("00000000-0000-0000-0000-000000000001"); expanded != want {
+		t.Errorf("Expected %s, got %s", want, expanded)
+	}
+}
+
+func TestWithPadding(t *testing.T) {
+	encoder, err := NewEncoder(DefaultAlphabet, WithPadding())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	u := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	short, err := encoder.ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != "0000000000000000000001" {
+		t.Errorf("Expected 0000000000000000000001, got %s", short)
+	}
+
+	// Unpadded short IDs are still accepted
+	for _, input := range []string{short, "1"} {
+		expanded, err := encoder.ExpandUUID(input)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", input, err)
+		}
+
+		if expanded != u {
+			t.Errorf("Expected %s, got %s", u, expanded)
+		}
+	}
+}
+
+func TestNewEncoder(t *testing.T) {
+	testCases := []struct {
+		name     string
+		alphabet string
+		wantErr  bool
+	}{
+		{"base62", string(defaultBase62), false},
+		{"binary", "01", false},
+		{"unicode", "αβγδ", false},
+		{"empty", "", true},
+		{"single character", "a", true},
+		{"duplicate character", "abca", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encoder, err := NewEncoder(tc.alphabet)
+			if tc.wantErr {
+				var encodeErr *EncodeError
+				if !errors.As(err, &encodeErr) {
+					t.Fatalf("Expected EncodeError, got %T: %v", err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error creating encoder: %v", err)
+			}
+
+			testUUID := uuid.New()
+			short, err := encoder.ShortenUUID(testUUID)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			expanded, err := encoder.ExpandUUID(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != testUUID {
+				t.Errorf("Expected %s, got %s", testUUID, expanded)
+			}
+		})
+	}
+}
+
+func TestWithAllowEmpty(t *testing.T) {
+	encoder, err := NewEncoder(string(defaultBase62), WithAllowEmpty())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	expanded, err := encoder.Expand("")
+	if err != nil {
+		t.Fatalf("Error expanding empty short ID: %v", err)
+	}
+	if expanded != "" {
+		t.Errorf("Expected empty string, got %q", expanded)
+	}
+
+	expandedUUID, err := encoder.ExpandUUID("")
+	if err != nil {
+		t.Fatalf("Error expanding empty short ID: %v", err)
+	}
+	if expandedUUID != uuid.Nil {
+		t.Errorf("Expected nil UUID, got %s", expandedUUID)
+	}
+}
+
+func TestWithMinBase(t *testing.T) {
+	_, err := NewEncoder("01", WithMinBase(32))
+
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Fatalf("Expected EncodeError, got %T: %v", err, err)
+	}
+
+	expectedReason := "alphabet has 2 characters, below the minimum base 32 (UUIDs would encode to 128 characters)"
+	if encodeErr.Reason != expectedReason {
+		t.Errorf("Expected reason %q, got %q", expectedReason, encodeErr.Reason)
+	}
+
+	if _, err := NewEncoder(string(defaultBase62), WithMinBase(32)); err != nil {
+		t.Errorf("Expected base62 to satisfy minimum base 32, got %v", err)
+	}
+
+	if _, err := NewEncoder("0123456789abcdef", WithMinBase(16)); err != nil {
+		t.Errorf("Expected base16 to satisfy minimum base 16, got %v", err)
+	}
+}
+
+func TestDigitsFor(t *testing.T) {
+	testCases := []struct {
+		bits, base, expected int
+	}{
+		{128, 2, 128},
+		{128, 16, 32},
+		{128, 36, 25},
+		{128, 54, 23},
+		{128, 62, 22},
+		{96, 62, 17},
+		{64, 62, 11},
+	}
+
+	for _, tc := range testCases {
+		if got := digitsFor(tc.bits, tc.base); got != tc.expected {
+			t.Errorf("Expected %d digits for %d bits in base %d, got %d", tc.expected, tc.bits, tc.base, got)
+		}
+	}
+}
+
+func TestWithName(t *testing.T) {
+	encoder, err := NewEncoder(string(defaultBase62), WithName("orders"))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	_, err = encoder.Expand("@#$%")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+
+	if decodeErr.Encoder != "orders" {
+		t.Errorf("Expected encoder name %q, got %q", "orders", decodeErr.Encoder)
+	}
+
+	expectedMsg := "decode error (encoder 'orders') for short ID '@#$%': invalid character '@' in short ID (valid characters: " + string(defaultBase62) + ")"
+	if decodeErr.Error() != expectedMsg {
+		t.Errorf("Expected error message %q, got %q", expectedMsg, decodeErr.Error())
+	}
+
+	_, err = encoder.ExpandUUID("zzzzzzzzzzzzzzzzzzzzzzzz")
+	if !errors.As(err, &decodeErr) || decodeErr.Encoder != "orders" {
+		t.Errorf("Expected DecodeError from encoder 'orders', got %v", err)
+	}
+
+	_, err = encoder.Shorten("")
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Fatalf("Expected EncodeError, got %T: %v", err, err)
+	}
+
+	if encodeErr.Encoder != "orders" {
+		t.Errorf("Expected encoder name %q, got %q", "orders", encodeErr.Encoder)
+	}
+
+	if !strings.Contains(encodeErr.Error(), "encoder 'orders'") {
+		t.Errorf("Expected error message to name the encoder, got %q", encodeErr.Error())
+	}
+
+	_, err = NewEncoder("01", WithMinBase(10), WithName("tiny"))
+	if !errors.As(err, &encodeErr) || encodeErr.Encoder != "tiny" {
+		t.Errorf("Expected EncodeError from encoder 'tiny', got %v", err)
+	}
+
+	// Unnamed encoders keep the original message format
+	_, err = Expand("@")
+	if !errors.As(err, &decodeErr) || strings.Contains(decodeErr.Error(), "encoder") {
+		t.Errorf("Expected unnamed DecodeError, got %v", err)
+	}
+}
+
+func TestVerifyUUIDBijection(t *testing.T) {
+	for _, encoder := range []*Encoder{defaultEncoder, NoLookalikes, QRAlphanumeric, DNSLabel, FilenameSafe, NanoIDAlphabet, FlickrBase58} {
+		if err := encoder.VerifyUUIDBijection(200); err != nil {
+			t.Errorf("Expected encoder with alphabet %q to pass, got %v", string(encoder.alphabet), err)
+		}
+	}
+
+	// A duplicated character makes decoding ambiguous
+	broken := newEncoder([]rune("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyA"), "broken")
+
+	err := broken.VerifyUUIDBijection(200)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError for broken encoder, got %T: %v", err, err)
+	}
+
+	t.Logf("broken encoder: %v", err)
+}
+
+func TestCompareEncoders(t *testing.T) {
+	same, err := NewEncoder(DefaultAlphabet, WithName("copy"))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	if differs, example := CompareEncoders(defaultEncoder, same, 200); differs {
+		t.Errorf("Expected identical alphabets to match, got difference %s", example)
+	}
+
+	// Swapping two characters changes the output for the nil UUID
+	swapped, err := NewEncoder("1023456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	differs, example := CompareEncoders(defaultEncoder, swapped, 200)
+	if !differs {
+		t.Fatal("Expected swapped alphabet to differ")
+	}
+
+	expected := `00000000-0000-0000-0000-000000000000: "0" vs "1"`
+	if example != expected {
+		t.Errorf("Expected %s, got %s", expected, example)
+	}
+
+	if differs, _ := CompareEncoders(defaultEncoder, NoLookalikes, 0); !differs {
+		t.Error("Expected different alphabets to differ")
+	}
+}
+
+func TestWithMaxBits(t *testing.T) {
+	encoder, err := NewEncoder(DefaultAlphabet, WithMaxBits(64))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	short, err := encoder.Shorten("8 bytes!")
+	if err != nil {
+		t.Fatalf("Error shortening string: %v", err)
+	}
+
+	expanded, err := encoder.Expand(short)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", short, err)
+	}
+
+	if expanded != "8 bytes!" {
+		t.Errorf("Expected 8 bytes!, got %s", expanded)
+	}
+
+	// A long run of digits would otherwise build a huge integer
+	adversarial := "z" + strings.Repeat("0", 100000)
+
+	nineBytes, err := Shorten("9 bytes!!")
+	if err != nil {
+		t.Fatalf("Error shortening string: %v", err)
+	}
+
+	for _, input := range []string{adversarial, nineBytes, "LygHa16AHYG"} {
+		_, err := encoder.Expand(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+		}
+
+		if decodeErr.Reason != "decoded value exceeds 64 bits" {
+			t.Errorf("Expected bit limit reason, got %q", decodeErr.Reason)
+		}
+	}
+
+	var dst [16]byte
+	if err := encoder.decode128("LygHa16AHYG", &dst); err == nil {
+		t.Error("Expected 128-bit decode to honor the bit limit")
+	}
+
+	if err := encoder.decode128("LygHa16AHYF", &dst); err != nil {
+		t.Errorf("Expected 2^64-1 to decode, got %v", err)
+	}
+}
+
+func TestWithRejectMaxUUID(t *testing.T) {
+	maxShort, err := ShortenUUID(uuid.Max)
+	if err != nil {
+		t.Fatalf("Error shortening max UUID: %v", err)
+	}
+
+	// Without the option the max UUID round-trips
+	expanded, err := ExpandUUID(maxShort)
+	if err != nil {
+		t.Fatalf("Error expanding max UUID: %v", err)
+	}
+	if expanded != uuid.Max {
+		t.Errorf("Expected %s, got %s", uuid.Max, expanded)
+	}
+
+	encoder, err := NewEncoder(string(defaultBase62), WithRejectMaxUUID())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	_, err = encoder.ExpandUUID(maxShort)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+
+	// Other UUIDs are unaffected
+	if _, err := encoder.ExpandUUID("2XrVqpuNYMfp5OSuawGnL1"); err != nil {
+		t.Errorf("Expected regular UUID to expand, got %v", err)
+	}
+}
+
+func BenchmarkShortenUUIDBytesVsHex(b *testing.B) {
+	testUUID := uuid.New()
+
+	// The former path: format the UUID, strip dashes and parse the hex string
+	b.Run("hex", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			num := new(big.Int)
+			num.SetString(strings.ReplaceAll(testUUID.String(), "-", ""), 16)
+			_ = defaultEncoder.intToBase(num)
+		}
+	})
+
+	// The current path: fixed 128-bit arithmetic on the UUID's bytes
+	b.Run("bytes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = defaultEncoder.encode128(testUUID)
+		}
+	})
+}
+
+func TestWithStrictWidth(t *testing.T) {
+	encoder, err := NewEncoder(string(defaultBase62), WithStrictWidth())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	// Leading-zero UUIDs are padded to the full width
+	for _, input := range []string{"1d152c86-c436-4d47-9269-006c7469b867", "00000000-0000-0000-0000-000000000000", "53a8d1b9-4eca-4888-9b59-8fa91497857b"} {
+		u := uuid.MustParse(input)
+
+		short, err := encoder.ShortenUUID(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", u, err)
+		}
+
+		if len(short) != 22 {
+			t.Errorf("Expected 22 characters, got %d in %s", len(short), short)
+		}
+
+		expanded, err := encoder.ExpandUUID(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != u {
+			t.Errorf("Expected %s, got %s", u, expanded)
+		}
+	}
+}
+
+func TestWithStrictWidthTruncated(t *testing.T) {
+	encoder, err := NewEncoder(string(defaultBase62), WithStrictWidth())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	short, err := encoder.ShortenUUID(uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"))
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	for _, cut := range []int{1, 2, 5, 21} {
+		truncated := short[:len(short)-cut]
+
+		_, err := encoder.ExpandUUID(truncated)
+		if !errors.Is(err, ErrTruncated) {
+			t.Errorf("Expected ErrTruncated for %s, got %v", truncated, err)
+		}
+
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %s, got %T", truncated, err)
+		}
+
+		// Without strict width the truncated ID silently decodes to another UUID
+		if _, err := ExpandUUID(truncated); err != nil {
+			t.Errorf("Expected lenient decode of %s to succeed, got %v", truncated, err)
+		}
+	}
+}
+
+func TestWithDescendingSort(t *testing.T) {
+	encoder, err := NewEncoder(string(defaultBase62), WithDescendingSort())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	uuids := []uuid.UUID{uuid.Nil, uuid.Max}
+	for i := 0; i < 50; i++ {
+		uuids = append(uuids, uuid.New())
+	}
+
+	// Sort UUIDs descending by their bytes
+	sort.Slice(uuids, func(i, j int) bool {
+		return bytes.Compare(uuids[i][:], uuids[j][:]) > 0
+	})
+
+	shorts := make([]string, len(uuids))
+	for i, u := range uuids {
+		short, err := encoder.ShortenUUID(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", u, err)
+		}
+
+		expanded, err := encoder.ExpandUUID(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != u {
+			t.Errorf("Expected %s, got %s", u, expanded)
+		}
+
+		shorts[i] = short
+	}
+
+	// Descending UUID order must be ascending string order
+	if !sort.StringsAreSorted(shorts) {
+		t.Error("Expected short IDs to sort in reverse UUID order")
+	}
+}
+
+func TestEncoderLookupSelection(t *testing.T) {
+	ascii, err := NewEncoder(string(defaultBase62))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	if ascii.ascii == nil || ascii.index != nil {
+		t.Errorf("Expected ASCII alphabet to use the array lookup")
+	}
+
+	unicode, err := NewEncoder("αβγδεζηθικλμνξοπρστυφχψω0123456789")
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	if unicode.ascii != nil || unicode.index == nil {
+		t.Fatalf("Expected unicode alphabet to fall back to the map lookup")
+	}
+
+	u := uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d")
+	short, err := unicode.ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	expanded, err := unicode.ExpandUUID(short)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", short, err)
+	}
+
+	if expanded != u {
+		t.Errorf("Expected %s, got %s", u, expanded)
+	}
+
+	// Characters outside the alphabet are rejected on both paths
+	for _, encoder := range []*Encoder{ascii, unicode} {
+		if _, err := encoder.ExpandUUID("ab€"); err == nil {
+			t.Errorf("Expected error for character outside the alphabet")
+		}
+	}
+}
+
+func BenchmarkExpandUUIDLookup(b *testing.B) {
+	short, err := ShortenUUID(uuid.New())
+	if err != nil {
+		b.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	array := newEncoder(defaultBase62, "")
+
+	// The same alphabet forced onto the map path
+	hashed := newEncoder(defaultBase62, "")
+	hashed.ascii = nil
+	hashed.index = make(map[rune]int, len(defaultBase62))
+	for i, char := range defaultBase62 {
+		hashed.index[char] = i
+	}
+
+	for name, encoder := range map[string]*Encoder{"array": array, "map": hashed} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = encoder.ExpandUUID(short)
+			}
+		})
+	}
+}
+
+func TestIntToBaseNoLeadingZeroGlyph(t *testing.T) {
+	values := []*big.Int{big.NewInt(0), big.NewInt(1)}
+
+	// Values around every power of the base, where a carry could go wrong
+	power := big.NewInt(1)
+	for i := 0; i < 25; i++ {
+		power = new(big.Int).Mul(power, big.NewInt(62))
+		values = append(values,
+			new(big.Int).Sub(power, big.NewInt(1)),
+			new(big.Int).Set(power),
+			new(big.Int).Add(power, big.NewInt(1)))
+	}
+
+	// Random values of up to 256 bits from a fixed seed
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		b := make([]byte, 1+rng.Intn(32))
+		rng.Read(b)
+		values = append(values, new(big.Int).SetBytes(b))
+	}
+
+	for _, encoder := range []*Encoder{defaultEncoder, NoLookalikes, QRAlphanumeric, NanoIDAlphabet} {
+		zeroGlyph := encoder.alphabet[0]
+		for _, value := range values {
+			short := []rune(encoder.intToBase(value))
+			if value.Sign() == 0 {
+				if string(short) != string(zeroGlyph) {
+					t.Errorf("Expected zero to encode to %c, got %s", zeroGlyph, string(short))
+				}
+				continue
+			}
+
+			if short[0] == zeroGlyph {
+				t.Errorf("Expected no leading %c for %s, got %s", zeroGlyph, value, string(short))
+			}
+		}
+	}
+}
+
+func TestPowerOfTwoPathMatchesGeneric(t *testing.T) {
+	alphabets := []string{
+		"01",
+		"0123",
+		"0123456789abcdef",
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZ234567",
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_",
+	}
+
+	// A 256-character alphabet of Unicode letters uses the map lookup
+	var wide []rune
+	for char := rune(0x100); len(wide) < 256; char++ {
+		wide = append(wide, char)
+	}
+	alphabets = append(alphabets, string(wide))
+
+	rng := rand.New(rand.NewSource(1))
+	values := []*big.Int{big.NewInt(0), big.NewInt(1), new(big.Int).SetBytes(uuid.Max[:])}
+	for i := 0; i < 200; i++ {
+		b := make([]byte, 1+rng.Intn(40))
+		rng.Read(b)
+		values = append(values, new(big.Int).SetBytes(b))
+	}
+
+	for _, alphabet := range alphabets {
+		fast, err := NewEncoder(alphabet)
+		if err != nil {
+			t.Fatalf("Error creating encoder: %v", err)
+		}
+
+		if fast.shift == 0 {
+			t.Fatalf("Expected %d-character alphabet to use the power-of-two path", len([]rune(alphabet)))
+		}
+
+		generic := *fast
+		generic.shift = 0
+
+		for _, value := range values {
+			want := generic.intToBase(value)
+			if got := fast.intToBase(value); got != want {
+				t.Errorf("Expected %s for %s in base %d, got %s", want, value, len(fast.alphabet), got)
+			}
+
+			decoded, err := fast.baseToInt(want)
+			if err != nil {
+				t.Fatalf("Error decoding %s: %v", want, err)
+			}
+
+			if decoded.Cmp(value) != 0 {
+				t.Errorf("Expected %s for %s in base %d, got %s", value, want, len(fast.alphabet), decoded)
+			}
+		}
+	}
+}
+
+func TestPowerOfTwoPathErrors(t *testing.T) {
+	fast, err := NewEncoder("0123456789abcdef", WithMaxBits(8))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	generic := *fast
+	generic.shift = 0
+
+	for _, input := range []string{"ff", "100", "00ff", "0100", "fg"} {
+		_, fastErr := fast.baseToInt(input)
+		_, genericErr := generic.baseToInt(input)
+
+		if (fastErr == nil) != (genericErr == nil) || (fastErr != nil && fastErr.Error() != genericErr.Error()) {
+			t.Errorf("Expected %v for %s, got %v", genericErr, input, fastErr)
+		}
+	}
+}
+
+func BenchmarkPowerOfTwoBase64URL(b *testing.B) {
+	fast, err := NewEncoder("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_")
+	if err != nil {
+		b.Fatalf("Error creating encoder: %v", err)
+	}
+
+	generic := *fast
+	generic.shift = 0
+
+	input := strings.Repeat("payload ", 8)
+	short, err := fast.Shorten(input)
+	if err != nil {
+		b.Fatalf("Error shortening string: %v", err)
+	}
+
+	for name, encoder := range map[string]*Encoder{"divmod": &generic, "shift": fast} {
+		b.Run(name+"/encode", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = encoder.Shorten(input)
+			}
+		})
+
+		b.Run(name+"/decode", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = encoder.Expand(short)
+			}
+		})
+	}
+}
+
+func TestEncode128MatchesEncodeBytes(t *testing.T) {
+	values := []uuid.UUID{uuid.Nil, uuid.Max, {15: 1}, {0: 0x80}}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		var u uuid.UUID
+		rng.Read(u[:rng.Intn(17)])
+		values = append(values, u)
+	}
+
+	unicode, err := NewEncoder("αβγδεζηθικλμνξοπρστυφχψω0123456789")
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	binary, err := NewEncoder("01")
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	for _, encoder := range []*Encoder{defaultEncoder, NoLookalikes, NanoIDAlphabet, FlickrBase58, unicode, binary} {
+		for _, u := range values {
+			want := encoder.encodeBytes(u[:])
+			if got := encoder.encode128(u); got != want {
+				t.Errorf("Expected %s for %s in base %d, got %s", want, u, len(encoder.alphabet), got)
+			}
+		}
+	}
+}
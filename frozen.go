@@ -0,0 +1,41 @@
+package shortuuid
+
+import "github.com/google/uuid"
+
+// FrozenEncoder is an immutable snapshot of an Encoder, for servers that
+// configure an encoder once and then only read from it. Its alphabet lookup
+// table is precomputed and it holds no mutable state, so it is safe for
+// concurrent use without any synchronization. String interning configured with
+// WithStringInterning is not carried over, since the intern table is mutable.
+type FrozenEncoder struct {
+	enc *Encoder
+}
+
+// Freeze returns an immutable snapshot of the encoder with the same alphabet
+// and options. Later changes to the Encoder do not affect the snapshot.
+func (e *Encoder) Freeze() *FrozenEncoder {
+	frozen := *e
+	frozen.alphabet = append([]rune(nil), e.alphabet...)
+	frozen.intern = nil
+	return &FrozenEncoder{enc: &frozen}
+}
+
+// Shorten converts any string to a short ID like Encoder.Shorten.
+func (f *FrozenEncoder) Shorten(input string) (string, error) {
+	return f.enc.Shorten(input)
+}
+
+// Expand converts a short ID back to the original string like Encoder.Expand.
+func (f *FrozenEncoder) Expand(shortID string) (string, error) {
+	return f.enc.Expand(shortID)
+}
+
+// ShortenUUID converts a uuid.UUID to a short ID like Encoder.ShortenUUID.
+func (f *FrozenEncoder) ShortenUUID(u uuid.UUID) (string, error) {
+	return f.enc.ShortenUUID(u)
+}
+
+// ExpandUUID converts a short ID back to a uuid.UUID like Encoder.ExpandUUID.
+func (f *FrozenEncoder) ExpandUUID(shortID string) (uuid.UUID, error) {
+	return f.enc.ExpandUUID(shortID)
+}
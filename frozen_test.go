@@ -0,0 +1,121 @@
+package shortuuid
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestFreeze(t *testing.T) {
+	frozen := NoLookalikes.Freeze()
+
+	for i := 0; i < 100; i++ {
+		u := uuid.New()
+
+		expected, err := NoLookalikes.ShortenUUID(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID: %v", err)
+		}
+
+		short, err := frozen.ShortenUUID(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID: %v", err)
+		}
+
+		if short != expected {
+			t.Errorf("Expected %s, got %s", expected, short)
+		}
+
+		expanded, err := frozen.ExpandUUID(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != u {
+			t.Errorf("Expected %s, got %s", u, expanded)
+		}
+	}
+
+	short, err := frozen.Shorten("hello world")
+	if err != nil {
+		t.Fatalf("Error shortening string: %v", err)
+	}
+
+	expanded, err := frozen.Expand(short)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", short, err)
+	}
+
+	if expanded != "hello world" {
+		t.Errorf("Expected hello world, got %s", expanded)
+	}
+}
+
+func TestFreezeKeepsOptions(t *testing.T) {
+	encoder, err := NewEncoder(DefaultAlphabet, WithStrictWidth(), WithName("frozen"), WithStringInterning(16))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+	frozen := encoder.Freeze()
+
+	short, err := frozen.ShortenUUID(uuid.Nil)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != "0000000000000000000000" {
+		t.Errorf("Expected padded nil UUID, got %s", short)
+	}
+
+	_, err = frozen.ExpandUUID("0")
+	if !errors.Is(err, ErrTruncated) {
+		t.Errorf("Expected ErrTruncated, got %v", err)
+	}
+
+	_, err = frozen.Expand("ab@")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+
+	if decodeErr.Encoder != "frozen" || decodeErr.Position != 3 {
+		t.Errorf("Expected encoder frozen at position 3, got %q at %d", decodeErr.Encoder, decodeErr.Position)
+	}
+}
+
+func TestFreezeConcurrent(t *testing.T) {
+	// Run with -race to check that the snapshot needs no synchronization
+	frozen := defaultEncoder.Freeze()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 200; i++ {
+				u := uuid.New()
+
+				short, err := frozen.ShortenUUID(u)
+				if err != nil {
+					t.Errorf("Error shortening UUID: %v", err)
+					return
+				}
+
+				expanded, err := frozen.ExpandUUID(short)
+				if err != nil {
+					t.Errorf("Error expanding short ID %s: %v", short, err)
+					return
+				}
+
+				if expanded != u {
+					t.Errorf("Expected %s, got %s", u, expanded)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,61 @@
+package shortuuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ShortenGrouped converts any string to a base62 short ID, then inserts
+// sep after every group characters, counted from the start, for display of
+// long IDs. It always uses base62, whatever SetDefaultEncoder selected, so the
+// separator check below matches the characters emitted. For example, "hello world" with group 4 and sep "-" becomes
+// "AAwf-93rv-y4aW-QVw". Use ExpandGrouped with the same separator to decode it.
+// Returns an error if the input is empty, group is not positive, or sep is
+// empty or contains a base62 character.
+func ShortenGrouped(input string, group int, sep string) (string, error) {
+	if group < 1 {
+		return "", &EncodeError{
+			Input:  input,
+			Reason: fmt.Sprintf("group size must be positive, got %d", group),
+		}
+	}
+
+	if sep == "" || strings.ContainsAny(sep, DefaultAlphabet) {
+		return "", &EncodeError{
+			Input:  input,
+			Reason: fmt.Sprintf("separator %q must be non-empty and contain no base62 characters", sep),
+		}
+	}
+
+	short, err := defaultEncoder.Shorten(input)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(short); i += group {
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(short[i:min(i+group, len(short))])
+	}
+
+	return b.String(), nil
+}
+
+// ExpandGrouped removes every occurrence of sep from a short ID produced by
+// ShortenGrouped and expands the result as base62. Group sizes are not
+// checked, so IDs regrouped for display still decode.
+// Returns an error if sep is empty or contains a base62 character, or if the
+// remaining short ID is empty or invalid.
+func ExpandGrouped(shortID string, sep string) (string, error) {
+	if sep == "" || strings.ContainsAny(sep, DefaultAlphabet) {
+		return "", &DecodeError{
+			ShortID: shortID,
+			Reason:  fmt.Sprintf("separator %q must be non-empty and contain no base62 characters", sep),
+			Code:    CodeMalformed,
+		}
+	}
+
+	return defaultEncoder.Expand(strings.ReplaceAll(shortID, sep, ""))
+}
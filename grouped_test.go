@@ -0,0 +1,106 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestShortenGrouped(t *testing.T) {
+	testCases := []struct {
+		group    int
+		sep      string
+		expected string
+	}{
+		{1, ".", "A.A.w.f.9.3.r.v.y.4.a.W.Q.V.w"},
+		{4, "-", "AAwf-93rv-y4aW-QVw"},
+		{5, " ", "AAwf9 3rvy4 aWQVw"},
+		{6, "--", "AAwf93--rvy4aW--QVw"},
+		{15, "-", "AAwf93rvy4aWQVw"},
+		{100, "-", "AAwf93rvy4aWQVw"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			grouped, err := ShortenGrouped("hello world", tc.group, tc.sep)
+			if err != nil {
+				t.Fatalf("Error shortening string: %v", err)
+			}
+
+			if grouped != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, grouped)
+			}
+
+			expanded, err := ExpandGrouped(grouped, tc.sep)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", grouped, err)
+			}
+
+			if expanded != "hello world" {
+				t.Errorf("Expected hello world, got %s", expanded)
+			}
+		})
+	}
+}
+
+func TestShortenGroupedInvalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		group int
+		sep   string
+	}{
+		{"empty input", "", 4, "-"},
+		{"zero group", "hello", 0, "-"},
+		{"negative group", "hello", -1, "-"},
+		{"empty separator", "hello", 4, ""},
+		{"alphabet separator", "hello", 4, "x"},
+		{"mixed separator", "hello", 4, "-a"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ShortenGrouped(tc.input, tc.group, tc.sep)
+			var encodeErr *EncodeError
+			if !errors.As(err, &encodeErr) {
+				t.Errorf("Expected EncodeError, got %T: %v", err, err)
+			}
+		})
+	}
+
+	for _, sep := range []string{"", "0"} {
+		_, err := ExpandGrouped("AAwf-93rv", sep)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for separator %q, got %T: %v", sep, err, err)
+		}
+	}
+}
+
+func TestShortenGroupedIgnoresDefaultEncoder(t *testing.T) {
+	t.Cleanup(func() { SetDefaultEncoder(nil) })
+
+	// An alphabet containing the separator must not leak into grouped IDs
+	dashed, err := NewEncoder("-" + DefaultAlphabet[1:])
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+	SetDefaultEncoder(dashed)
+
+	grouped, err := ShortenGrouped("hello world", 4, "-")
+	if err != nil {
+		t.Fatalf("Error shortening: %v", err)
+	}
+
+	if grouped != "AAwf-93rv-y4aW-QVw" {
+		t.Errorf("Expected AAwf-93rv-y4aW-QVw, got %s", grouped)
+	}
+
+	expanded, err := ExpandGrouped(grouped, "-")
+	if err != nil {
+		t.Fatalf("Error expanding %s: %v", grouped, err)
+	}
+
+	if expanded != "hello world" {
+		t.Errorf("Expected hello world, got %s", expanded)
+	}
+}
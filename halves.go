@@ -0,0 +1,38 @@
+package shortuuid
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/google/uuid"
+)
+
+// ShortenUUIDHalves encodes the high and low 64 bits of a UUID as two separate
+// base62 short IDs, for schemas that store IDs in two 64-bit columns. Each half
+// is at most 11 characters and is not padded, so a half with value zero
+// encodes to "0". ExpandUUIDHalves reverses it.
+func ShortenUUIDHalves(u uuid.UUID) (hi, lo string, err error) {
+	hi = defaultEncoder.intToBase(new(big.Int).SetUint64(binary.BigEndian.Uint64(u[:8])))
+	lo = defaultEncoder.intToBase(new(big.Int).SetUint64(binary.BigEndian.Uint64(u[8:])))
+	return hi, lo, nil
+}
+
+// ExpandUUIDHalves converts the two halves created by ShortenUUIDHalves back
+// to a UUID.
+// Returns an error if either half is invalid or exceeds 64 bits.
+func ExpandUUIDHalves(hi, lo string) (uuid.UUID, error) {
+	hiValue, err := DecodeUint64(hi)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	loValue, err := DecodeUint64(lo)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	var u uuid.UUID
+	binary.BigEndian.PutUint64(u[:8], hiValue)
+	binary.BigEndian.PutUint64(u[8:], loValue)
+	return u, nil
+}
@@ -0,0 +1,69 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenUUIDHalves(t *testing.T) {
+	testCases := []struct {
+		uuid string
+		hi   string
+		lo   string
+	}{
+		{"00000000-0000-0000-0000-000000000000", "0", "0"},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", "LygHa16AHYF", "LygHa16AHYF"},
+		{"00000000-0000-0001-0000-000000000000", "1", "0"},
+		{"00000000-0000-0000-0000-00000000003e", "0", "10"},
+		{"000000ff-0000-0000-8000-000000000001", "JHTdUcS", "AzL8n0Y58m9"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.uuid, func(t *testing.T) {
+			u := uuid.MustParse(tc.uuid)
+
+			hi, lo, err := ShortenUUIDHalves(u)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			if hi != tc.hi || lo != tc.lo {
+				t.Errorf("Expected %s/%s, got %s/%s", tc.hi, tc.lo, hi, lo)
+			}
+
+			expanded, err := ExpandUUIDHalves(hi, lo)
+			if err != nil {
+				t.Fatalf("Error expanding halves %s/%s: %v", hi, lo, err)
+			}
+
+			if expanded != u {
+				t.Errorf("Expected %s, got %s", u, expanded)
+			}
+		})
+	}
+
+	// Padded halves decode to the same UUID
+	expanded, err := ExpandUUIDHalves("00000000001", "00000000000")
+	if err != nil || expanded != uuid.MustParse("00000000-0000-0001-0000-000000000000") {
+		t.Errorf("Expected padded halves to decode, got %s (error: %v)", expanded, err)
+	}
+}
+
+func TestExpandUUIDHalvesInvalid(t *testing.T) {
+	testCases := [][2]string{
+		{"", "0"},
+		{"0", ""},
+		{"LygHa16AHYG", "0"},
+		{"0", "@"},
+	}
+
+	for _, tc := range testCases {
+		_, err := ExpandUUIDHalves(tc[0], tc[1])
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q/%q, got %T: %v", tc[0], tc[1], err, err)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package shortuuid
+
+// WithHomoglyphNormalization makes decoding accept Cyrillic and Greek letters
+// that look identical to Latin ones, such as Cyrillic 'е' (U+0435) for 'e', by
+// mapping them to their Latin equivalents before lookup. This rescues IDs that
+// were retyped or copied through keyboards and fonts of other locales. Only the
+// characters in homoglyphs are mapped, and only when the Latin equivalent is in
+// the encoder alphabet; encoding is unaffected.
+func WithHomoglyphNormalization() Option {
+	return func(e *Encoder) {
+		e.homoglyphs = true
+	}
+}
+
+// homoglyphs maps Cyrillic and Greek letters to the Latin letters they are
+// visually indistinguishable from in common fonts
+var homoglyphs = map[rune]rune{
+	// Cyrillic capitals
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O',
+	'Р': 'P', 'С': 'C', 'Т': 'T', 'Х': 'X', 'І': 'I', 'Ј': 'J', 'Ѕ': 'S',
+	// Cyrillic lowercase
+	'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x',
+	'і': 'i', 'ј': 'j', 'ѕ': 's', 'ԁ': 'd',
+	// Greek capitals
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K',
+	'Μ': 'M', 'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+	// Greek lowercase
+	'ο': 'o',
+}
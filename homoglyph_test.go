@@ -0,0 +1,71 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestWithHomoglyphNormalization(t *testing.T) {
+	encoder, err := NewEncoder(DefaultAlphabet, WithHomoglyphNormalization())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	expected := uuid.MustParse("8658bb57-992d-4a4d-9292-a5b118d28c8b")
+
+	testCases := map[string]string{
+		"Latin":         "45VWNy74cXYBydTM0JO3rv",
+		"Cyrillic":      "45VWNу74сХYВуdТМ0JО3rv",
+		"Greek":         "45VWNy74cΧΥΒydΤΜ0JΟ3rv",
+		"Cyrillic J":    "45VWNy74cXYBydTM0ЈO3rv",
+		"mixed scripts": "45VWΝу74сXYΒуԁTM0JО3rv",
+	}
+
+	for name, shortID := range testCases {
+		t.Run(name, func(t *testing.T) {
+			expanded, err := encoder.ExpandUUID(shortID)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", shortID, err)
+			}
+
+			if expanded != expected {
+				t.Errorf("Expected %s, got %s", expected, expanded)
+			}
+		})
+	}
+
+	// Frozen snapshots keep the option
+	expanded, err := encoder.Freeze().ExpandUUID("45VWNу74сХYВуdТМ0JО3rv")
+	if err != nil || expanded != expected {
+		t.Errorf("Expected frozen encoder to return %s, got %s (error: %v)", expected, expanded, err)
+	}
+
+	// Without the option homoglyphs are invalid characters
+	_, err = ExpandUUID("45VWNу74сХYВуdТМ0JО3rv")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+
+	if decodeErr.Position != 6 {
+		t.Errorf("Expected position 6, got %d", decodeErr.Position)
+	}
+}
+
+func TestWithHomoglyphNormalizationAlphabet(t *testing.T) {
+	// Homoglyphs of letters outside the alphabet stay invalid
+	encoder, err := NewEncoder(string(QRAlphanumeric.alphabet), WithHomoglyphNormalization())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	if _, err := encoder.Expand("А"); err != nil {
+		t.Errorf("Expected Cyrillic capital A to decode, got %v", err)
+	}
+
+	if _, err := encoder.Expand("а"); err == nil {
+		t.Error("Expected Cyrillic lowercase a to fail without lowercase in the alphabet")
+	}
+}
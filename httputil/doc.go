@@ -0,0 +1,5 @@
+// Package httputil provides net/http helpers for decoding short IDs from requests.
+//
+// The helpers depend only on the standard library. Reading route variables
+// relies on http.Request.PathValue and therefore requires Go 1.22 or later.
+package httputil
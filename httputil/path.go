@@ -0,0 +1,28 @@
+//go:build go1.22
+
+package httputil
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/nhalm/shortuuid"
+)
+
+// UUIDFromPath reads the route variable key from r, as matched by an
+// http.ServeMux pattern such as "/items/{id}", and expands it as a short UUID.
+// Any failure, including a missing variable, is returned as a
+// *shortuuid.DecodeError, which callers can map to a 400 response.
+func UUIDFromPath(r *http.Request, key string) (uuid.UUID, error) {
+	value := r.PathValue(key)
+	if value == "" {
+		return uuid.UUID{}, &shortuuid.DecodeError{
+			ShortID: value,
+			Reason:  fmt.Sprintf("missing path value '%s'", key),
+			Code:    shortuuid.CodeEmpty,
+		}
+	}
+
+	return shortuuid.ExpandUUID(value)
+}
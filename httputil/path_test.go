@@ -0,0 +1,60 @@
+//go:build go1.22
+
+package httputil
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhalm/shortuuid"
+)
+
+func TestUUIDFromPath(t *testing.T) {
+	expected := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+
+	r := httptest.NewRequest("GET", "/items/2XrVqpuNYMfp5OSuawGnL1", nil)
+	r.SetPathValue("id", "2XrVqpuNYMfp5OSuawGnL1")
+
+	u, err := UUIDFromPath(r, "id")
+	if err != nil {
+		t.Fatalf("Error reading UUID from path: %v", err)
+	}
+
+	if u != expected {
+		t.Errorf("Expected %s, got %s", expected, u)
+	}
+}
+
+func TestUUIDFromPathInvalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		key   string
+		value string
+		code  shortuuid.ErrorCode
+	}{
+		{"invalid characters", "id", "not-a-short-id", shortuuid.CodeInvalidChar},
+		{"oversized", "id", "zzzzzzzzzzzzzzzzzzzzzzzz", shortuuid.CodeOverflow},
+		{"missing", "other", "", shortuuid.CodeEmpty},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/items/x", nil)
+			if tc.value != "" {
+				r.SetPathValue(tc.key, tc.value)
+			}
+
+			_, err := UUIDFromPath(r, "id")
+			var decodeErr *shortuuid.DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+			}
+
+			if decodeErr.Code != tc.code {
+				t.Errorf("Expected code %v, got %v", tc.code, decodeErr.Code)
+			}
+		})
+	}
+}
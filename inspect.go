@@ -0,0 +1,66 @@
+package shortuuid
+
+// InspectResult holds diagnostic information about a short ID.
+// Numeric fields describe the value as decoded with the default base62 alphabet.
+type InspectResult struct {
+	ByteLength int      // Number of bytes Expand would return, including leading zero bytes
+	Value      string   // Decoded integer value in base 10
+	IsUUID     bool     // Whether the short ID expands to a valid UUID
+	Alphabets  []string // Names of built-in alphabets containing every character of the short ID
+}
+
+// builtinAlphabet pairs a built-in alphabet with a human-readable name.
+type builtinAlphabet struct {
+	name     string
+	alphabet []rune
+}
+
+// builtinAlphabets lists the alphabets shipped with the package
+var builtinAlphabets = []builtinAlphabet{
+	{name: "base62", alphabet: defaultBase62},
+	{name: "nolookalikes", alphabet: NoLookalikes.alphabet},
+	{name: "qralphanumeric", alphabet: QRAlphanumeric.alphabet},
+	{name: "dnslabel", alphabet: DNSLabel.alphabet},
+	{name: "filenamesafe", alphabet: FilenameSafe.alphabet},
+	{name: "nanoid", alphabet: NanoIDAlphabet.alphabet},
+	{name: "flickrbase58", alphabet: FlickrBase58.alphabet},
+}
+
+// Inspect decodes a short ID and reports its encoding characteristics for
+// debugging and support tooling. It returns the same errors as Expand.
+func Inspect(shortID string) (InspectResult, error) {
+	num, err := defaultEncoder.baseToInt(shortID)
+	if err != nil {
+		return InspectResult{}, err
+	}
+
+	_, uuidErr := defaultEncoder.ExpandUUID(shortID)
+
+	return InspectResult{
+		ByteLength: len(defaultEncoder.appendValue(nil, num, shortID)),
+		Value:      num.String(),
+		IsUUID:     uuidErr == nil,
+		Alphabets:  matchingAlphabets(shortID),
+	}, nil
+}
+
+// matchingAlphabets returns the names of built-in alphabets that contain every character of s
+func matchingAlphabets(s string) []string {
+	var names []string
+	for _, b := range builtinAlphabets {
+		if containsOnly(s, b.alphabet) {
+			names = append(names, b.name)
+		}
+	}
+	return names
+}
+
+// containsOnly reports whether every character of s appears in alphabet
+func containsOnly(s string, alphabet []rune) bool {
+	for _, char := range s {
+		if runeIndex(alphabet, char) == -1 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	testCases := []struct {
+		name       string
+		shortID    string
+		byteLength int
+		value      string
+		isUUID     bool
+	}{
+		{
+			name:       "UUID short ID",
+			shortID:    "2XrVqpuNYMfp5OSuawGnL1",
+			byteLength: 16,
+			value:      "111202483227583473142595336565668939131",
+			isUUID:     true,
+		},
+		{
+			name:       "string short ID",
+			shortID:    "AAwf93rvy4aWQVw",
+			byteLength: 11,
+			value:      "126207244316550804821666916",
+			isUUID:     true,
+		},
+		{
+			name:       "leading zero byte",
+			shortID:    "01Z",
+			byteLength: 2,
+			value:      "97",
+			isUUID:     true,
+		},
+		{
+			name:       "oversized short ID",
+			shortID:    "zzzzzzzzzzzzzzzzzzzzzzzz",
+			byteLength: 18,
+			value:      "10408797222153426578715765348940396820955135",
+			isUUID:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Inspect(tc.shortID)
+			if err != nil {
+				t.Fatalf("Error inspecting short ID %s: %v", tc.shortID, err)
+			}
+
+			if result.ByteLength != tc.byteLength {
+				t.Errorf("Expected byte length %d, got %d", tc.byteLength, result.ByteLength)
+			}
+
+			if result.Value != tc.value {
+				t.Errorf("Expected value %s, got %s", tc.value, result.Value)
+			}
+
+			if result.IsUUID != tc.isUUID {
+				t.Errorf("Expected IsUUID %t, got %t", tc.isUUID, result.IsUUID)
+			}
+
+			if len(result.Alphabets) == 0 || result.Alphabets[0] != "base62" {
+				t.Errorf("Expected base62 among alphabets, got %v", result.Alphabets)
+			}
+
+			t.Logf("%s -> %+v", tc.shortID, result)
+		})
+	}
+}
+
+func TestInspectInvalid(t *testing.T) {
+	_, err := Inspect("@#$%")
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+}
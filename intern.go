@@ -0,0 +1,59 @@
+package shortuuid
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// WithStringInterning makes ShortenUUID return shared string instances for
+// repeated UUIDs, which reduces memory in workloads that encode the same small
+// set of UUIDs over and over. At most maxEntries UUIDs are remembered; once the
+// table is full, further UUIDs are encoded normally without being stored.
+// A non-positive maxEntries disables interning.
+func WithStringInterning(maxEntries int) Option {
+	return func(e *Encoder) {
+		if maxEntries <= 0 {
+			e.intern = nil
+			return
+		}
+
+		e.intern = &internTable{
+			max:     maxEntries,
+			entries: make(map[uuid.UUID]string),
+		}
+	}
+}
+
+// internTable is a bounded, goroutine-safe map from UUIDs to their short IDs
+type internTable struct {
+	mu      sync.RWMutex
+	max     int
+	entries map[uuid.UUID]string
+}
+
+// lookup returns the interned short ID for u, if any
+func (t *internTable) lookup(u uuid.UUID) (string, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	short, ok := t.entries[u]
+	return short, ok
+}
+
+// store interns short for u and returns the shared instance.
+// If another goroutine stored u first, its instance wins.
+func (t *internTable) store(u uuid.UUID, short string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if existing, ok := t.entries[u]; ok {
+		return existing
+	}
+
+	if len(t.entries) < t.max {
+		t.entries[u] = short
+	}
+
+	return short
+}
@@ -0,0 +1,105 @@
+package shortuuid
+
+import (
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/google/uuid"
+)
+
+func TestStringInterning(t *testing.T) {
+	encoder, err := NewEncoder(string(defaultBase62), WithStringInterning(2))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	testUUID := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+
+	first, err := encoder.ShortenUUID(testUUID)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	second, err := encoder.ShortenUUID(testUUID)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if first != "2XrVqpuNYMfp5OSuawGnL1" {
+		t.Errorf("Expected 2XrVqpuNYMfp5OSuawGnL1, got %s", first)
+	}
+
+	// Interned strings share the same backing data
+	if unsafe.StringData(first) != unsafe.StringData(second) {
+		t.Error("Expected repeated inputs to return the same string instance")
+	}
+}
+
+func TestStringInterningBounded(t *testing.T) {
+	encoder, err := NewEncoder(string(defaultBase62), WithStringInterning(2))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		if _, err := encoder.ShortenUUID(uuid.New()); err != nil {
+			t.Fatalf("Error shortening UUID: %v", err)
+		}
+	}
+
+	if len(encoder.intern.entries) != 2 {
+		t.Errorf("Expected 2 interned entries, got %d", len(encoder.intern.entries))
+	}
+}
+
+func TestStringInterningConcurrent(t *testing.T) {
+	encoder, err := NewEncoder(string(defaultBase62), WithStringInterning(16))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	uuids := make([]uuid.UUID, 32)
+	for i := range uuids {
+		uuids[i] = uuid.New()
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, u := range uuids {
+				short, err := encoder.ShortenUUID(u)
+				if err != nil {
+					t.Errorf("Error shortening UUID: %v", err)
+					return
+				}
+
+				expanded, err := encoder.ExpandUUID(short)
+				if err != nil || expanded != u {
+					t.Errorf("Expected %s, got %s (err: %v)", u, expanded, err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkShortenUUIDInterned(b *testing.B) {
+	encoder, err := NewEncoder(string(defaultBase62), WithStringInterning(1024))
+	if err != nil {
+		b.Fatal(err)
+	}
+	testUUID := uuid.New()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := encoder.ShortenUUID(testUUID)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package shortuuid
+
+import (
+	"net"
+	"unicode/utf8"
+)
+
+// ShortenIP converts an IP address to a short, URL-safe identifier using the
+// same 128-bit byte path as UUIDs. IPv6 addresses are encoded from their 16
+// bytes and left-padded with '0' to a fixed 22 characters. IPv4 addresses,
+// given in 4-byte or IPv4-mapped 16-byte form, are zero-extended to 128 bits,
+// so they encode to at most 6 characters; ExpandIP uses the width to tell the
+// two apart, and IPv6 addresses such as ::1 never decode as IPv4.
+// Returns an error if ip is not a valid 4- or 16-byte address.
+func ShortenIP(ip net.IP) (string, error) {
+	if ip4 := ip.To4(); ip4 != nil {
+		var value [16]byte
+		copy(value[12:], ip4)
+		return defaultEncoder.encode128(value), nil
+	}
+
+	if len(ip) != net.IPv6len {
+		return "", &EncodeError{
+			Input:  ip.String(),
+			Reason: "IP address must be 4 or 16 bytes",
+		}
+	}
+
+	return defaultEncoder.padLeft(defaultEncoder.encode128([16]byte(ip)), uuidWidth), nil
+}
+
+// ExpandIP converts a short ID created by ShortenIP back to an IP address.
+// A 22-character short ID is an IPv6 address and decodes to 16 bytes; a
+// shorter one is a zero-extended IPv4 address and decodes to 4 bytes.
+// Returns an error if the short ID is invalid, exceeds 128 bits, or is shorter
+// than 22 characters with a value above 32 bits.
+func ExpandIP(shortID string) (net.IP, error) {
+	size := net.IPv6len
+	if utf8.RuneCountInString(shortID) < uuidWidth {
+		size = net.IPv4len
+	}
+
+	b, err := defaultEncoder.decodeFixed(shortID, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return net.IP(b), nil
+}
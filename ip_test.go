@@ -0,0 +1,124 @@
+package shortuuid
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestShortenIP(t *testing.T) {
+	testCases := []string{
+		"::",
+		"::1",
+		"2001:db8::1",
+		"fe80::1ff:fe23:4567:890a",
+		"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff",
+		"0.0.0.0",
+		"127.0.0.1",
+		"192.168.1.254",
+		"255.255.255.255",
+	}
+
+	for _, addr := range testCases {
+		t.Run(addr, func(t *testing.T) {
+			ip := net.ParseIP(addr)
+			if ip == nil {
+				t.Fatalf("Error parsing IP %s", addr)
+			}
+
+			short, err := ShortenIP(ip)
+			if err != nil {
+				t.Fatalf("Error shortening IP %s: %v", addr, err)
+			}
+
+			expanded, err := ExpandIP(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if !expanded.Equal(ip) {
+				t.Errorf("Expected IP %s, got %s", ip, expanded)
+			}
+
+			t.Logf("IP: %s -> Short: %s -> IP: %s", addr, short, expanded)
+		})
+	}
+}
+
+func TestShortenIPv4Forms(t *testing.T) {
+	// The 4-byte and IPv4-mapped 16-byte forms of an address are the same IP
+	v4 := net.IPv4(10, 0, 0, 1).To4()
+	mapped := v4.To16()
+
+	shortV4, err := ShortenIP(v4)
+	if err != nil {
+		t.Fatalf("Error shortening IPv4: %v", err)
+	}
+
+	shortMapped, err := ShortenIP(mapped)
+	if err != nil {
+		t.Fatalf("Error shortening IPv4-mapped IPv6: %v", err)
+	}
+
+	if shortV4 != shortMapped {
+		t.Errorf("Expected identical short IDs, got %s and %s", shortV4, shortMapped)
+	}
+
+	// Zero-extended: 10.0.0.1 is the integer 167772161
+	if shortV4 != "BLxCb" {
+		t.Errorf("Expected BLxCb, got %s", shortV4)
+	}
+
+	expanded, err := ExpandIP(shortV4)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", shortV4, err)
+	}
+
+	if len(expanded) != net.IPv4len || !expanded.Equal(v4) {
+		t.Errorf("Expected 4-byte IPv4 %s, got %v", v4, []byte(expanded))
+	}
+
+	// ::1 and 0.0.0.1 share their value but not their width
+	loopback, err := ShortenIP(net.IPv6loopback)
+	if err != nil {
+		t.Fatalf("Error shortening IPv6 loopback: %v", err)
+	}
+
+	if loopback != "0000000000000000000001" {
+		t.Errorf("Expected 0000000000000000000001, got %s", loopback)
+	}
+
+	expanded, err = ExpandIP(loopback)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", loopback, err)
+	}
+
+	if len(expanded) != net.IPv6len || !expanded.Equal(net.IPv6loopback) {
+		t.Errorf("Expected IPv6 loopback, got %s", expanded)
+	}
+}
+
+func TestShortenIPErrors(t *testing.T) {
+	_, err := ShortenIP(net.IP{1, 2, 3})
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Errorf("Expected EncodeError for 3-byte IP, got %T: %v", err, err)
+	}
+
+	_, err = ExpandIP("zzzzzzzzzzzzzzzzzzzzzzzz")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError for oversized short ID, got %T: %v", err, err)
+	}
+
+	// Shorter than 22 characters but above 32 bits
+	_, err = ExpandIP("zzzzzz")
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError for oversized IPv4 short ID, got %T: %v", err, err)
+	}
+
+	_, err = ExpandIP("@#$%")
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError for invalid short ID, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,86 @@
+package shortuuid
+
+import (
+	"math/big"
+	"strings"
+)
+
+// DecodeInt converts a short ID to the integer it represents in base62.
+// Returns an error if the short ID is empty or contains invalid characters.
+func DecodeInt(shortID string) (*big.Int, error) {
+	return defaultEncoder.baseToInt(shortID)
+}
+
+// ExpandWithValue converts a short ID back to the original string like Expand,
+// and also returns the underlying integer so callers needing both avoid a
+// second decode. The returned integer is owned by the caller.
+func ExpandWithValue(shortID string) (string, *big.Int, error) {
+	num, err := defaultEncoder.baseToInt(shortID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return string(defaultEncoder.appendValue(nil, num, shortID)), num, nil
+}
+
+// DecodeUint64 converts a short ID, such as one produced by Counter, to the
+// uint64 it represents in base62.
+// Returns an error if the short ID is invalid or exceeds 64 bits.
+func DecodeUint64(shortID string) (uint64, error) {
+	num, err := defaultEncoder.baseToInt(shortID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !num.IsUint64() {
+		return 0, &DecodeError{
+			ShortID: shortID,
+			Reason:  "decoded value exceeds 64 bits",
+			Code:    CodeOverflow,
+		}
+	}
+
+	return num.Uint64(), nil
+}
+
+// ShortenDecimal converts a non-negative base-10 integer string of any size,
+// such as a legacy numeric ID too large for uint64, to a base62 short ID.
+// Leading zeros in the input are not preserved.
+// Returns an error if the input is empty, negative, or not a decimal integer.
+func ShortenDecimal(decStr string) (string, error) {
+	if decStr == "" {
+		return "", &EncodeError{
+			Input:  decStr,
+			Reason: "input string cannot be empty",
+		}
+	}
+
+	if strings.HasPrefix(decStr, "-") {
+		return "", &EncodeError{
+			Input:  decStr,
+			Reason: "negative values cannot be encoded",
+		}
+	}
+
+	if strings.Trim(decStr, "0123456789") != "" {
+		return "", &EncodeError{
+			Input:  decStr,
+			Reason: "input is not a decimal integer",
+		}
+	}
+
+	num, _ := new(big.Int).SetString(decStr, 10)
+	return defaultEncoder.intToBase(num), nil
+}
+
+// ExpandDecimal converts a short ID back to the base-10 integer string it
+// represents, without leading zeros.
+// Returns an error if the short ID is empty or contains invalid characters.
+func ExpandDecimal(shortID string) (string, error) {
+	num, err := defaultEncoder.baseToInt(shortID)
+	if err != nil {
+		return "", err
+	}
+
+	return num.String(), nil
+}
@@ -0,0 +1,145 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecodeInt(t *testing.T) {
+	testCases := map[string]int64{
+		"0":  0,
+		"z":  61,
+		"10": 62,
+		"zz": 3843,
+	}
+
+	for shortID, expected := range testCases {
+		t.Run(shortID, func(t *testing.T) {
+			num, err := DecodeInt(shortID)
+			if err != nil {
+				t.Fatalf("Error decoding %s: %v", shortID, err)
+			}
+
+			if num.Int64() != expected {
+				t.Errorf("Expected %d, got %s", expected, num)
+			}
+		})
+	}
+}
+
+func TestExpandWithValue(t *testing.T) {
+	short, err := Shorten("hello world")
+	if err != nil {
+		t.Fatalf("Error shortening string: %v", err)
+	}
+
+	expanded, value, err := ExpandWithValue(short)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", short, err)
+	}
+
+	if expanded != "hello world" {
+		t.Errorf("Expected 'hello world', got '%s'", expanded)
+	}
+
+	independent, err := DecodeInt(short)
+	if err != nil {
+		t.Fatalf("Error decoding short ID %s: %v", short, err)
+	}
+
+	if value.Cmp(independent) != 0 {
+		t.Errorf("Expected value %s, got %s", independent, value)
+	}
+
+	_, _, err = ExpandWithValue("@#$%")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError, got %T: %v", err, err)
+	}
+}
+
+func TestDecodeUint64(t *testing.T) {
+	testCases := map[string]uint64{
+		"0":           0,
+		"00000000000": 0,
+		"10":          62,
+		"LygHa16AHYF": 18446744073709551615,
+	}
+
+	for shortID, expected := range testCases {
+		t.Run(shortID, func(t *testing.T) {
+			v, err := DecodeUint64(shortID)
+			if err != nil {
+				t.Fatalf("Error decoding %s: %v", shortID, err)
+			}
+
+			if v != expected {
+				t.Errorf("Expected %d, got %d", expected, v)
+			}
+		})
+	}
+
+	for _, input := range []string{"LygHa16AHYG", "@", ""} {
+		_, err := DecodeUint64(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+}
+
+func TestShortenDecimal(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"0", "0"},
+		{"61", "z"},
+		{"62", "10"},
+		{"18446744073709551616", "LygHa16AHYG"},
+		{"340282366920938463463374607431768211455", "7n42DGM5Tflk9n8mt7Fhc7"},
+		{"123456789012345678901234567890123456789012345678901234567890", "sK0FUywPQsEhMwNhdPBZJcA9KumP0WpD0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			short, err := ShortenDecimal(tc.input)
+			if err != nil {
+				t.Fatalf("Error shortening %s: %v", tc.input, err)
+			}
+
+			if short != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, short)
+			}
+
+			expanded, err := ExpandDecimal(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != tc.input {
+				t.Errorf("Expected %s, got %s", tc.input, expanded)
+			}
+		})
+	}
+}
+
+func TestShortenDecimalInvalid(t *testing.T) {
+	for _, input := range []string{"", "-1", "+1", "12a4", "1.5", "1_000", " 12"} {
+		_, err := ShortenDecimal(input)
+		var encodeErr *EncodeError
+		if !errors.As(err, &encodeErr) {
+			t.Errorf("Expected EncodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+
+	// Leading zeros are not part of the value
+	short, err := ShortenDecimal("00062")
+	if err != nil {
+		t.Fatalf("Error shortening 00062: %v", err)
+	}
+
+	if short != "10" {
+		t.Errorf("Expected 10, got %s", short)
+	}
+}
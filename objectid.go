@@ -0,0 +1,44 @@
+package shortuuid
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	objectIDBytes = 12 // Size of a MongoDB ObjectID
+	objectIDWidth = 17 // Base62 characters needed for 96 bits
+)
+
+// ShortenObjectID converts a MongoDB ObjectID, given as 24 hex characters, to a
+// fixed-width 17-character short ID. Shorter encodings are left-padded with '0'.
+// Returns an error if the input is not exactly 24 hex characters.
+func ShortenObjectID(hexID string) (string, error) {
+	if len(hexID) != 2*objectIDBytes {
+		return "", &EncodeError{
+			Input:  hexID,
+			Reason: fmt.Sprintf("ObjectID must be %d hex characters, got %d", 2*objectIDBytes, len(hexID)),
+		}
+	}
+
+	b, err := hex.DecodeString(hexID)
+	if err != nil {
+		return "", &EncodeError{
+			Input:  hexID,
+			Reason: "ObjectID is not valid hex: " + err.Error(),
+		}
+	}
+
+	return defaultEncoder.padLeft(defaultEncoder.encodeBytes(b), objectIDWidth), nil
+}
+
+// ExpandObjectID converts a short ID back to a 24-character lowercase hex ObjectID.
+// Returns an error if the short ID is invalid or exceeds 96 bits.
+func ExpandObjectID(shortID string) (string, error) {
+	b, err := defaultEncoder.decodeFixed(shortID, objectIDBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
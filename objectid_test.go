@@ -0,0 +1,61 @@
+package shortuuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestShortenObjectID(t *testing.T) {
+	testCases := []string{
+		"507f1f77bcf86cd799439011",
+		"5f8d0d55b54764421b7156c9",
+		"65a1b2c3d4e5f60718293a4b",
+		"000000000000000000000000",
+		"00000000000000000000002a",
+		"ffffffffffffffffffffffff",
+		"507F1F77BCF86CD799439011",
+	}
+
+	for _, oid := range testCases {
+		t.Run(oid, func(t *testing.T) {
+			short, err := ShortenObjectID(oid)
+			if err != nil {
+				t.Fatalf("Error shortening ObjectID %s: %v", oid, err)
+			}
+
+			if len(short) != 17 {
+				t.Errorf("Expected 17 characters, got %d in %s", len(short), short)
+			}
+
+			expanded, err := ExpandObjectID(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != strings.ToLower(oid) {
+				t.Errorf("Expected %s, got %s", strings.ToLower(oid), expanded)
+			}
+
+			t.Logf("ObjectID: %s -> Short: %s", oid, short)
+		})
+	}
+}
+
+func TestShortenObjectIDErrors(t *testing.T) {
+	for _, input := range []string{"", "507f1f77bcf86cd79943901", "507f1f77bcf86cd7994390111", "507f1f77bcf86cd79943901g"} {
+		_, err := ShortenObjectID(input)
+		var encodeErr *EncodeError
+		if !errors.As(err, &encodeErr) {
+			t.Errorf("Expected EncodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+
+	for _, input := range []string{"", "@#$%", "zzzzzzzzzzzzzzzzzz"} {
+		_, err := ExpandObjectID(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package shortuuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/google/uuid"
+)
+
+// obscuredRounds is the number of Feistel rounds applied by ObscuredID
+const obscuredRounds = 8
+
+// ObscuredID produces short IDs that hide the UUID they encode, so that
+// sequential UUIDs such as version 7 do not yield guessable public IDs.
+// The UUID is passed through a keyed, reversible permutation of the 128-bit
+// space (a Feistel network with HMAC-SHA256 round functions) before encoding;
+// only holders of the secret can map an ID back to its UUID.
+//
+// This is obfuscation, not encryption with a security proof: use a long random
+// secret, and do not rely on it to protect sensitive data.
+// An ObscuredID is safe for concurrent use.
+type ObscuredID struct {
+	secret []byte
+}
+
+// NewObscuredID creates an ObscuredID keyed by secret. The secret is copied.
+func NewObscuredID(secret []byte) *ObscuredID {
+	return &ObscuredID{secret: append([]byte(nil), secret...)}
+}
+
+// Encode permutes u with the secret and returns the result as a 22-character
+// short ID, left-padded with '0'.
+func (o *ObscuredID) Encode(u uuid.UUID) string {
+	left := binary.BigEndian.Uint64(u[:8])
+	right := binary.BigEndian.Uint64(u[8:])
+
+	for round := 0; round < obscuredRounds; round++ {
+		left, right = right, left^o.roundFunc(round, right)
+	}
+
+	var permuted uuid.UUID
+	binary.BigEndian.PutUint64(permuted[:8], left)
+	binary.BigEndian.PutUint64(permuted[8:], right)
+	return defaultEncoder.padLeft(defaultEncoder.encodeBytes(permuted[:]), uuidWidth)
+}
+
+// Decode reverses Encode. Decoding with a different secret does not fail; it
+// yields an unrelated UUID.
+// Returns an error if the short ID is invalid or exceeds 128 bits.
+func (o *ObscuredID) Decode(s string) (uuid.UUID, error) {
+	b, err := defaultEncoder.decodeFixed(s, 16)
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+
+	left := binary.BigEndian.Uint64(b[:8])
+	right := binary.BigEndian.Uint64(b[8:])
+
+	for round := obscuredRounds - 1; round >= 0; round-- {
+		left, right = right^o.roundFunc(round, left), left
+	}
+
+	var u uuid.UUID
+	binary.BigEndian.PutUint64(u[:8], left)
+	binary.BigEndian.PutUint64(u[8:], right)
+	return u, nil
+}
+
+// roundFunc derives the 64-bit Feistel round value for half in the given round
+func (o *ObscuredID) roundFunc(round int, half uint64) uint64 {
+	var msg [9]byte
+	msg[0] = byte(round)
+	binary.BigEndian.PutUint64(msg[1:], half)
+
+	mac := hmac.New(sha256.New, o.secret)
+	mac.Write(msg[:])
+	return binary.BigEndian.Uint64(mac.Sum(nil))
+}
@@ -0,0 +1,76 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestObscuredID(t *testing.T) {
+	obscurer := NewObscuredID([]byte("correct horse battery staple"))
+
+	testCases := []uuid.UUID{
+		uuid.Nil,
+		uuid.Max,
+		uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"),
+		uuid.New(),
+	}
+
+	for _, u := range testCases {
+		t.Run(u.String(), func(t *testing.T) {
+			encoded := obscurer.Encode(u)
+			if len(encoded) != 22 {
+				t.Errorf("Expected 22 characters, got %d in %s", len(encoded), encoded)
+			}
+
+			plain, err := ShortenUUID(u)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+			if encoded == plain {
+				t.Errorf("Expected obscured ID to differ from the plain short ID %s", plain)
+			}
+
+			decoded, err := obscurer.Decode(encoded)
+			if err != nil {
+				t.Fatalf("Error decoding %s: %v", encoded, err)
+			}
+
+			if decoded != u {
+				t.Errorf("Expected %s, got %s", u, decoded)
+			}
+		})
+	}
+}
+
+func TestObscuredIDWrongSecret(t *testing.T) {
+	u := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+	encoded := NewObscuredID([]byte("secret one")).Encode(u)
+
+	decoded, err := NewObscuredID([]byte("secret two")).Decode(encoded)
+	if err != nil {
+		t.Fatalf("Error decoding %s: %v", encoded, err)
+	}
+
+	if decoded == u {
+		t.Error("Expected a different secret to not recover the UUID")
+	}
+}
+
+func TestObscuredIDSequential(t *testing.T) {
+	obscurer := NewObscuredID([]byte("correct horse battery staple"))
+
+	// Adjacent UUIDs must not produce adjacent IDs
+	a := obscurer.Encode(uuid.MustParse("01890a5d-ac96-774b-bcce-b302099a8057"))
+	b := obscurer.Encode(uuid.MustParse("01890a5d-ac96-774b-bcce-b302099a8058"))
+	if a[:10] == b[:10] {
+		t.Errorf("Expected sequential UUIDs to diverge, got %s and %s", a, b)
+	}
+
+	_, err := obscurer.Decode("zzzzzzzzzzzzzzzzzzzzzzzz")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,100 @@
+package shortuuid
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ShortenUUIDPair packs two UUIDs into a single token. Each UUID is encoded and
+// left-padded with '0' to 22 characters, so the token is always 44 characters
+// and splits unambiguously.
+func ShortenUUIDPair(a, b uuid.UUID) (string, error) {
+	shortA, err := defaultEncoder.ShortenUUID(a)
+	if err != nil {
+		return "", err
+	}
+
+	shortB, err := defaultEncoder.ShortenUUID(b)
+	if err != nil {
+		return "", err
+	}
+
+	return defaultEncoder.padLeft(shortA, uuidWidth) + defaultEncoder.padLeft(shortB, uuidWidth), nil
+}
+
+// ExpandUUIDPair converts a token created by ShortenUUIDPair back to its two UUIDs.
+// Returns an error naming the failing half if either half is invalid.
+func ExpandUUIDPair(s string) (a, b uuid.UUID, err error) {
+	return expandUUIDPair(s, false)
+}
+
+// ExpandUUIDPairStrict is like ExpandUUIDPair but additionally requires both
+// halves to be well-formed RFC 4122 UUIDs: the variant must be RFC 4122 and
+// the version between 1 and 8. Use it for pair tokens from untrusted sources.
+func ExpandUUIDPairStrict(s string) (a, b uuid.UUID, err error) {
+	return expandUUIDPair(s, true)
+}
+
+// expandUUIDPair splits and decodes a pair token, optionally validating each half
+func expandUUIDPair(s string, strict bool) (uuid.UUID, uuid.UUID, error) {
+	if len(s) != 2*uuidWidth {
+		return uuid.UUID{}, uuid.UUID{}, &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("invalid pair length: expected %d characters, got %d", 2*uuidWidth, len(s)),
+			Code:    CodeBadLength,
+		}
+	}
+
+	a, err := expandPairHalf(s, s[:uuidWidth], "first", strict)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+
+	b, err := expandPairHalf(s, s[uuidWidth:], "second", strict)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, err
+	}
+
+	return a, b, nil
+}
+
+// expandPairHalf decodes one half of a pair token, reporting failures against the whole token
+func expandPairHalf(token, half, which string, strict bool) (uuid.UUID, error) {
+	u, err := defaultEncoder.ExpandUUID(half)
+	if err != nil {
+		reason := err.Error()
+		code := CodeUnknown
+		var decodeErr *DecodeError
+		if errors.As(err, &decodeErr) {
+			reason = decodeErr.Reason
+			code = decodeErr.Code
+		}
+		return uuid.UUID{}, &DecodeError{
+			ShortID: token,
+			Reason:  which + " half: " + reason,
+			Code:    code,
+		}
+	}
+
+	if strict {
+		if u.Variant() != uuid.RFC4122 {
+			return uuid.UUID{}, &DecodeError{
+				ShortID: token,
+				Reason:  fmt.Sprintf("%s half: invalid UUID variant %s", which, u.Variant()),
+				Code:    CodeInvalidUUID,
+			}
+		}
+
+		if v := u.Version(); v < 1 || v > 8 {
+			return uuid.UUID{}, &DecodeError{
+				ShortID: token,
+				Reason:  fmt.Sprintf("%s half: invalid UUID version %d", which, v),
+				Code:    CodeInvalidUUID,
+			}
+		}
+	}
+
+	return u, nil
+}
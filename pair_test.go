@@ -0,0 +1,94 @@
+package shortuuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenUUIDPair(t *testing.T) {
+	a := uuid.MustParse("1d152c86-c436-4d47-9269-006c7469b867") // encodes to 21 characters
+	b := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+
+	token, err := ShortenUUIDPair(a, b)
+	if err != nil {
+		t.Fatalf("Error shortening pair: %v", err)
+	}
+
+	if len(token) != 44 {
+		t.Fatalf("Expected 44 characters, got %d in %s", len(token), token)
+	}
+
+	for _, expand := range []func(string) (uuid.UUID, uuid.UUID, error){ExpandUUIDPair, ExpandUUIDPairStrict} {
+		gotA, gotB, err := expand(token)
+		if err != nil {
+			t.Fatalf("Error expanding pair %s: %v", token, err)
+		}
+
+		if gotA != a || gotB != b {
+			t.Errorf("Expected (%s, %s), got (%s, %s)", a, b, gotA, gotB)
+		}
+	}
+}
+
+func TestExpandUUIDPairStrict(t *testing.T) {
+	valid := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+	badVariant := uuid.MustParse("53a8d1b9-4eca-4888-1b59-8fa91497857b")
+	badVersion := uuid.MustParse("53a8d1b9-4eca-0888-9b59-8fa91497857b")
+
+	testCases := []struct {
+		name  string
+		a, b  uuid.UUID
+		which string
+	}{
+		{"first half bad variant", badVariant, valid, "first half"},
+		{"second half bad variant", valid, badVariant, "second half"},
+		{"second half bad version", valid, badVersion, "second half"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			token, err := ShortenUUIDPair(tc.a, tc.b)
+			if err != nil {
+				t.Fatalf("Error shortening pair: %v", err)
+			}
+
+			// The lenient decoder accepts any 128-bit values
+			if _, _, err := ExpandUUIDPair(token); err != nil {
+				t.Errorf("Expected lenient expand to succeed, got %v", err)
+			}
+
+			_, _, err = ExpandUUIDPairStrict(token)
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+			}
+
+			if !strings.HasPrefix(decodeErr.Reason, tc.which) {
+				t.Errorf("Expected reason to name the %s, got %q", tc.which, decodeErr.Reason)
+			}
+		})
+	}
+}
+
+func TestExpandUUIDPairInvalid(t *testing.T) {
+	valid := "2XrVqpuNYMfp5OSuawGnL1"
+
+	testCases := []string{
+		"",
+		valid,
+		valid + valid + "0",
+		valid + "2XrVqpuNYMfp5OSuawG@L1",
+		valid + "zzzzzzzzzzzzzzzzzzzzzz",
+	}
+
+	for _, input := range testCases {
+		_, _, err := ExpandUUIDPair(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+}
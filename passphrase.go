@@ -0,0 +1,70 @@
+package shortuuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// passphraseRounds is the number of SHA-256 iterations that stretch a passphrase
+const passphraseRounds = 10000
+
+// NewEncoderFromPassphrase returns an Encoder whose alphabet is the base62
+// alphabet shuffled deterministically by passphrase, so teams can configure
+// ID obfuscation with a memorable secret. The same passphrase always yields
+// the same ordering, on every platform and Go version; different passphrases
+// yield unrelated orderings.
+//
+// The passphrase is stretched with iterated SHA-256 and drives a Fisher-Yates
+// shuffle. This is obfuscation, not security: short IDs reveal the alphabet
+// ordering to anyone who collects enough of them, and there are far fewer
+// orderings worth guessing than the key space suggests. Use ObscuredID to hide
+// UUIDs from casual inspection with a real keyed permutation.
+func NewEncoderFromPassphrase(passphrase string) *Encoder {
+	key := sha256.Sum256([]byte("shortuuid alphabet passphrase\x00" + passphrase))
+	for i := 1; i < passphraseRounds; i++ {
+		key = sha256.Sum256(key[:])
+	}
+
+	stream := passphraseStream{key: key}
+	alphabet := append([]rune(nil), defaultBase62...)
+	for i := len(alphabet) - 1; i > 0; i-- {
+		j := stream.intn(i + 1)
+		alphabet[i], alphabet[j] = alphabet[j], alphabet[i]
+	}
+
+	return newEncoder(alphabet, "0-9, A-Z, a-z")
+}
+
+// passphraseStream is a deterministic stream of numbers from SHA-256 in counter mode
+type passphraseStream struct {
+	key     [32]byte
+	counter uint64
+	block   []byte
+}
+
+// intn returns a uniform number in [0, n) by rejection sampling
+func (s *passphraseStream) intn(n int) int {
+	limit := ^uint32(0) - ^uint32(0)%uint32(n)
+	for {
+		if v := s.next(); v < limit {
+			return int(v % uint32(n))
+		}
+	}
+}
+
+// next returns the next 32 bits of the stream
+func (s *passphraseStream) next() uint32 {
+	if len(s.block) < 4 {
+		var input [40]byte
+		copy(input[:], s.key[:])
+		binary.BigEndian.PutUint64(input[32:], s.counter)
+		s.counter++
+
+		block := sha256.Sum256(input[:])
+		s.block = block[:]
+	}
+
+	v := binary.BigEndian.Uint32(s.block)
+	s.block = s.block[4:]
+	return v
+}
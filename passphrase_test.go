@@ -0,0 +1,60 @@
+package shortuuid
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewEncoderFromPassphrase(t *testing.T) {
+	first := NewEncoderFromPassphrase("correct horse battery staple")
+	second := NewEncoderFromPassphrase("correct horse battery staple")
+
+	if string(first.alphabet) != string(second.alphabet) {
+		t.Errorf("Expected the same alphabet, got %s and %s", string(first.alphabet), string(second.alphabet))
+	}
+
+	// The ordering is pinned so that it never changes between releases
+	if want := "zsBU17bYQmwAWZ5JHohxanOvITrVudCNiXyD3qcPkKlF4MgEeL08G2tS9Rfpj6"; string(first.alphabet) != want {
+		t.Errorf("Expected %s, got %s", want, string(first.alphabet))
+	}
+
+	// The alphabet is a permutation of base62
+	seen := make(map[rune]bool)
+	for _, char := range first.alphabet {
+		if runeIndex(defaultBase62, char) == -1 || seen[char] {
+			t.Fatalf("Expected a permutation of base62, got %s", string(first.alphabet))
+		}
+		seen[char] = true
+	}
+
+	if len(seen) != len(defaultBase62) {
+		t.Errorf("Expected %d characters, got %d", len(defaultBase62), len(seen))
+	}
+
+	u := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+	short, err := first.ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	expanded, err := second.ExpandUUID(short)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", short, err)
+	}
+
+	if expanded != u {
+		t.Errorf("Expected %s, got %s", u, expanded)
+	}
+}
+
+func TestNewEncoderFromPassphraseDiverges(t *testing.T) {
+	alphabets := make(map[string]string)
+	for _, passphrase := range []string{"", "a", "b", "correct horse battery staple", "correct horse battery staple "} {
+		alphabet := string(NewEncoderFromPassphrase(passphrase).alphabet)
+		if other, ok := alphabets[alphabet]; ok {
+			t.Errorf("Expected passphrases %q and %q to yield different alphabets", other, passphrase)
+		}
+		alphabets[alphabet] = passphrase
+	}
+}
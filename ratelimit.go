@@ -0,0 +1,83 @@
+package shortuuid
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRateLimited is returned by a RateLimitedEncoder when a decode exceeds the
+// configured rate.
+var ErrRateLimited = errors.New("shortuuid: decode rate limit exceeded")
+
+// RateLimitedEncoder wraps an Encoder and limits how often short IDs can be
+// decoded, to slow down enumeration of public endpoints. It uses a token
+// bucket that holds up to one second's worth of decodes, so bursts up to the
+// per-second rate are allowed before decodes are throttled.
+// A RateLimitedEncoder is safe for concurrent use.
+type RateLimitedEncoder struct {
+	base *Encoder
+
+	mu     sync.Mutex
+	rate   float64          // Tokens added per second, also the bucket capacity
+	tokens float64          // Tokens currently available
+	last   time.Time        // When tokens was last refilled
+	now    func() time.Time // Clock, replaceable in tests
+}
+
+// NewRateLimitedEncoder wraps base so that at most perSecond decodes succeed
+// per second on average. The bucket starts full. Values of perSecond below 1
+// are treated as 1.
+func NewRateLimitedEncoder(base *Encoder, perSecond int) *RateLimitedEncoder {
+	if perSecond < 1 {
+		perSecond = 1
+	}
+
+	return &RateLimitedEncoder{
+		base:   base,
+		rate:   float64(perSecond),
+		tokens: float64(perSecond),
+		now:    time.Now,
+	}
+}
+
+// ShortenUUID converts a uuid.UUID to a short ID like Encoder.ShortenUUID.
+// Encoding is not rate limited.
+func (r *RateLimitedEncoder) ShortenUUID(u uuid.UUID) (string, error) {
+	return r.base.ShortenUUID(u)
+}
+
+// ExpandUUID converts a short ID back to a uuid.UUID like Encoder.ExpandUUID.
+// Returns ErrRateLimited without decoding if the rate has been exceeded.
+func (r *RateLimitedEncoder) ExpandUUID(shortID string) (uuid.UUID, error) {
+	if !r.allow() {
+		return uuid.UUID{}, ErrRateLimited
+	}
+
+	return r.base.ExpandUUID(shortID)
+}
+
+// allow takes a token from the bucket, refilling it for the time elapsed since
+// the last call, and reports whether one was available
+func (r *RateLimitedEncoder) allow() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if !r.last.IsZero() {
+		r.tokens += now.Sub(r.last).Seconds() * r.rate
+		if r.tokens > r.rate {
+			r.tokens = r.rate
+		}
+	}
+	r.last = now
+
+	if r.tokens < 1 {
+		return false
+	}
+
+	r.tokens--
+	return true
+}
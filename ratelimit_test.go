@@ -0,0 +1,93 @@
+package shortuuid
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRateLimitedEncoder(t *testing.T) {
+	limited := NewRateLimitedEncoder(defaultEncoder, 5)
+
+	clock := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	limited.now = func() time.Time { return clock }
+
+	u := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+	short, err := limited.ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	// The full bucket allows a burst of 5
+	for i := 0; i < 5; i++ {
+		expanded, err := limited.ExpandUUID(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID on call %d: %v", i, err)
+		}
+
+		if expanded != u {
+			t.Errorf("Expected %s, got %s", u, expanded)
+		}
+	}
+
+	_, err = limited.ExpandUUID(short)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited after burst, got %v", err)
+	}
+
+	// 200ms refills one token at 5 per second
+	clock = clock.Add(200 * time.Millisecond)
+	if _, err := limited.ExpandUUID(short); err != nil {
+		t.Errorf("Expected decode after refill, got %v", err)
+	}
+
+	if _, err := limited.ExpandUUID(short); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Expected ErrRateLimited, got %v", err)
+	}
+
+	// A long pause refills no more than the bucket capacity
+	clock = clock.Add(time.Hour)
+	allowed := 0
+	for i := 0; i < 10; i++ {
+		if _, err := limited.ExpandUUID(short); err == nil {
+			allowed++
+		}
+	}
+
+	if allowed != 5 {
+		t.Errorf("Expected 5 decodes after refill, got %d", allowed)
+	}
+}
+
+func TestRateLimitedEncoderConcurrent(t *testing.T) {
+	limited := NewRateLimitedEncoder(defaultEncoder, 50)
+	limited.now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	short, err := ShortenUUID(uuid.New())
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	var allowed atomic.Int64
+	var wg sync.WaitGroup
+	for g := 0; g < 10; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				if _, err := limited.ExpandUUID(short); err == nil {
+					allowed.Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if allowed.Load() != 50 {
+		t.Errorf("Expected exactly 50 decodes with a frozen clock, got %d", allowed.Load())
+	}
+}
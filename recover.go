@@ -0,0 +1,53 @@
+package shortuuid
+
+import (
+	"bytes"
+
+	"github.com/google/uuid"
+)
+
+// maxRecoverChars bounds how many lost trailing characters RecoverUUID tries
+const maxRecoverChars = 2
+
+// RecoverUUID is a best-effort recovery tool for short IDs that lost trailing
+// characters, for example in cut-off log lines. It tries every completion of
+// truncatedShort with 1 or 2 extra base62 characters (at most 3906 candidates)
+// and reports the UUID whose leading bytes match knownPrefix. Trailing zero
+// bytes of knownPrefix are treated as unknown.
+//
+// The lost characters carry the lowest bits of the UUID, so knownPrefix has to
+// reach into the final bytes to single out one completion. If no completion or
+// more than one completion matches, RecoverUUID returns false.
+func RecoverUUID(truncatedShort string, knownPrefix uuid.UUID) (uuid.UUID, bool) {
+	known := len(knownPrefix)
+	for known > 0 && knownPrefix[known-1] == 0 {
+		known--
+	}
+
+	var found uuid.UUID
+	matches := 0
+	for n := 1; n <= maxRecoverChars; n++ {
+		suffix := make([]byte, n)
+		candidates := 1
+		for i := 0; i < n; i++ {
+			candidates *= len(DefaultAlphabet)
+		}
+
+		for c := 0; c < candidates; c++ {
+			for i, rest := n-1, c; i >= 0; i, rest = i-1, rest/len(DefaultAlphabet) {
+				suffix[i] = DefaultAlphabet[rest%len(DefaultAlphabet)]
+			}
+
+			u, err := defaultEncoder.ExpandUUID(truncatedShort + string(suffix))
+			if err == nil && bytes.Equal(u[:known], knownPrefix[:known]) {
+				found = u
+				matches++
+			}
+		}
+	}
+
+	if matches != 1 {
+		return uuid.UUID{}, false
+	}
+	return found, true
+}
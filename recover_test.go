@@ -0,0 +1,53 @@
+package shortuuid
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestRecoverUUID(t *testing.T) {
+	u := uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d")
+	short, err := ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	recovered, ok := RecoverUUID(short[:len(short)-1], u)
+	if !ok {
+		t.Fatalf("Expected to recover %s from %s", u, short[:len(short)-1])
+	}
+
+	if recovered != u {
+		t.Errorf("Expected %s, got %s", u, recovered)
+	}
+}
+
+func TestRecoverUUIDAmbiguous(t *testing.T) {
+	u := uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d")
+	short, err := ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	// Only the first 8 bytes are known, which every completion shares
+	var prefix uuid.UUID
+	copy(prefix[:8], u[:8])
+
+	if recovered, ok := RecoverUUID(short[:len(short)-1], prefix); ok {
+		t.Errorf("Expected no unique recovery, got %s", recovered)
+	}
+}
+
+func TestRecoverUUIDNoMatch(t *testing.T) {
+	u := uuid.MustParse("3b1f8b40-7c6e-4b8a-9c4e-7d3a1f2b5c6d")
+	short, err := ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	other := uuid.MustParse("ffffffff-7c6e-4b8a-9c4e-7d3a1f2b5c6d")
+	if recovered, ok := RecoverUUID(short[:len(short)-1], other); ok {
+		t.Errorf("Expected no recovery, got %s", recovered)
+	}
+}
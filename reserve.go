@@ -0,0 +1,42 @@
+package shortuuid
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+)
+
+// maxUUID is the largest 128-bit value, 2^128 - 1
+var maxUUID = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// ReserveRange returns count consecutive short IDs starting at start, treating
+// the UUID as a 128-bit integer and incrementing it. Offline clients can mint
+// IDs from a reserved block without colliding with other blocks.
+// Returns an error if count is negative or the range would pass the maximum UUID.
+func ReserveRange(start uuid.UUID, count int) ([]string, error) {
+	if count < 0 {
+		return nil, &EncodeError{
+			Input:  start.String(),
+			Reason: fmt.Sprintf("count cannot be negative, got %d", count),
+		}
+	}
+
+	num := new(big.Int).SetBytes(start[:])
+	last := new(big.Int).Add(num, big.NewInt(int64(count)-1))
+	if count > 0 && last.Cmp(maxUUID) > 0 {
+		return nil, &EncodeError{
+			Input:  start.String(),
+			Reason: fmt.Sprintf("range of %d IDs overflows 128 bits", count),
+		}
+	}
+
+	one := big.NewInt(1)
+	ids := make([]string, count)
+	for i := range ids {
+		ids[i] = defaultEncoder.intToBase(num)
+		num.Add(num, one)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,68 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestReserveRange(t *testing.T) {
+	start := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+
+	ids, err := ReserveRange(start, 5)
+	if err != nil {
+		t.Fatalf("Error reserving range: %v", err)
+	}
+
+	if len(ids) != 5 {
+		t.Fatalf("Expected 5 IDs, got %d", len(ids))
+	}
+
+	if ids[0] != "2XrVqpuNYMfp5OSuawGnL1" {
+		t.Errorf("Expected first ID to be the start, got %s", ids[0])
+	}
+
+	expected := []string{"2XrVqpuNYMfp5OSuawGnL1", "2XrVqpuNYMfp5OSuawGnL2", "2XrVqpuNYMfp5OSuawGnL3", "2XrVqpuNYMfp5OSuawGnL4", "2XrVqpuNYMfp5OSuawGnL5"}
+	for i, id := range ids {
+		if id != expected[i] {
+			t.Errorf("Expected ID %d to be %s, got %s", i, expected[i], id)
+		}
+
+		if _, err := ExpandUUID(id); err != nil {
+			t.Errorf("Error expanding reserved ID %s: %v", id, err)
+		}
+	}
+
+	empty, err := ReserveRange(start, 0)
+	if err != nil || len(empty) != 0 {
+		t.Errorf("Expected empty range, got %v (err: %v)", empty, err)
+	}
+}
+
+func TestReserveRangeOverflow(t *testing.T) {
+	nearMax := uuid.MustParse("ffffffff-ffff-ffff-ffff-fffffffffffd")
+
+	// Exactly reaching the maximum UUID is allowed
+	ids, err := ReserveRange(nearMax, 3)
+	if err != nil {
+		t.Fatalf("Error reserving range up to the maximum: %v", err)
+	}
+
+	last, err := ExpandUUID(ids[2])
+	if err != nil {
+		t.Fatalf("Error expanding %s: %v", ids[2], err)
+	}
+
+	if last != uuid.Max {
+		t.Errorf("Expected last ID to be the max UUID, got %s", last)
+	}
+
+	for _, count := range []int{4, -1} {
+		_, err = ReserveRange(nearMax, count)
+		var encodeErr *EncodeError
+		if !errors.As(err, &encodeErr) {
+			t.Errorf("Expected EncodeError for count %d, got %T: %v", count, err, err)
+		}
+	}
+}
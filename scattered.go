@@ -0,0 +1,40 @@
+package shortuuid
+
+import (
+	"math/bits"
+
+	"github.com/google/uuid"
+)
+
+// ShortenUUIDScattered reverses the order of the UUID's 128 bits before
+// encoding, so that UUIDs sharing a common prefix, such as version 7 UUIDs
+// generated close together, produce short IDs that spread across the keyspace
+// instead of piling onto one partition of a range-sharded store. The result is
+// left-padded with '0' to 22 characters. This trades away sortability: unlike
+// ShortenUUID on version 7 UUIDs, or WithDescendingSort, scattered IDs carry no
+// useful order. ExpandUUIDScattered reverses the transformation.
+func ShortenUUIDScattered(u uuid.UUID) string {
+	reversed := reverseUUIDBits(u)
+	return defaultEncoder.padLeft(defaultEncoder.encodeBytes(reversed[:]), uuidWidth)
+}
+
+// ExpandUUIDScattered converts a short ID created by ShortenUUIDScattered back
+// to the original UUID.
+// Returns an error if the short ID is invalid or exceeds 128 bits.
+func ExpandUUIDScattered(shortID string) (uuid.UUID, error) {
+	var reversed uuid.UUID
+	if err := defaultEncoder.decode128(shortID, (*[16]byte)(&reversed)); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	return reverseUUIDBits(reversed), nil
+}
+
+// reverseUUIDBits mirrors the 128 bits of u, so the last bit becomes the first
+func reverseUUIDBits(u uuid.UUID) uuid.UUID {
+	var reversed uuid.UUID
+	for i, b := range u {
+		reversed[len(u)-1-i] = bits.Reverse8(b)
+	}
+	return reversed
+}
@@ -0,0 +1,76 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenUUIDScattered(t *testing.T) {
+	testCases := []uuid.UUID{
+		uuid.Nil,
+		uuid.Max,
+		uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"),
+		uuid.Must(uuid.NewV7()),
+	}
+
+	for _, u := range testCases {
+		t.Run(u.String(), func(t *testing.T) {
+			short := ShortenUUIDScattered(u)
+			if len(short) != 22 {
+				t.Errorf("Expected 22 characters, got %d in %s", len(short), short)
+			}
+
+			expanded, err := ExpandUUIDScattered(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != u {
+				t.Errorf("Expected %s, got %s", u, expanded)
+			}
+		})
+	}
+
+	// The lowest bit becomes the highest
+	if short := ShortenUUIDScattered(uuid.MustParse("00000000-0000-0000-0000-000000000001")); short != "3tX16dB2jpss4tZORYcqo4" {
+		t.Errorf("Expected 3tX16dB2jpss4tZORYcqo4, got %s", short)
+	}
+
+	_, err := ExpandUUIDScattered("zzzzzzzzzzzzzzzzzzzzzz")
+	if !errors.Is(err, ErrUUIDOverflow) {
+		t.Errorf("Expected ErrUUIDOverflow, got %v", err)
+	}
+}
+
+func TestShortenUUIDScatteredDistribution(t *testing.T) {
+	// Version 7 UUIDs generated back to back share their timestamp prefix
+	const count = 1000
+	plainPrefixes := make(map[string]bool)
+	scatteredPrefixes := make(map[string]bool)
+
+	for i := 0; i < count; i++ {
+		u := uuid.Must(uuid.NewV7())
+
+		short, err := ShortenUUID(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID: %v", err)
+		}
+
+		plainPrefixes[short[:2]] = true
+		scatteredPrefixes[ShortenUUIDScattered(u)[:2]] = true
+	}
+
+	t.Logf("distinct 2-character prefixes: plain %d, scattered %d", len(plainPrefixes), len(scatteredPrefixes))
+
+	// Scattered IDs start with 0-7 followed by any character, so up to 8*62 prefixes
+	if len(scatteredPrefixes) < 300 {
+		t.Errorf("Expected scattered IDs to spread over at least 300 prefixes, got %d", len(scatteredPrefixes))
+	}
+
+	if len(plainPrefixes) >= len(scatteredPrefixes) {
+		t.Errorf("Expected scattering to increase prefix diversity, got plain %d vs scattered %d", len(plainPrefixes), len(scatteredPrefixes))
+	}
+}
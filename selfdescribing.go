@@ -0,0 +1,114 @@
+package shortuuid
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+
+	"github.com/google/uuid"
+)
+
+// Kinds of payload carried by self-describing short IDs. The kind is stored as
+// the base62 digit in the first character, so the values must stay stable.
+const (
+	KindUUID     = 0 // 16-byte UUID
+	KindObjectID = 1 // 12-byte MongoDB ObjectID
+	KindIP       = 2 // 16-byte IPv6 or IPv4-mapped address
+)
+
+// selfDescribingKind describes how the payload of a kind is stored
+type selfDescribingKind struct {
+	size  int // Payload size in bytes
+	width int // Fixed width of the encoded payload
+}
+
+// selfDescribingKinds is indexed by kind
+var selfDescribingKinds = []selfDescribingKind{
+	KindUUID:     {size: 16, width: uuidWidth},
+	KindObjectID: {size: objectIDBytes, width: objectIDWidth},
+	KindIP:       {size: net.IPv6len, width: uuidWidth},
+}
+
+// ShortenUUIDSelfDescribing converts a UUID to a self-describing short ID: the
+// kind character '0' followed by the UUID padded to 22 characters.
+// Decode it with DecodeSelfDescribing.
+func ShortenUUIDSelfDescribing(u uuid.UUID) string {
+	return encodeSelfDescribing(KindUUID, u[:])
+}
+
+// ShortenObjectIDSelfDescribing converts a MongoDB ObjectID, given as 24 hex
+// characters, to a self-describing short ID: the kind character '1' followed by
+// the 17-character short ObjectID.
+// Returns an error if the input is not exactly 24 hex characters.
+func ShortenObjectIDSelfDescribing(hexID string) (string, error) {
+	if len(hexID) != 2*objectIDBytes {
+		return "", &EncodeError{
+			Input:  hexID,
+			Reason: fmt.Sprintf("ObjectID must be %d hex characters, got %d", 2*objectIDBytes, len(hexID)),
+		}
+	}
+
+	b, err := hex.DecodeString(hexID)
+	if err != nil {
+		return "", &EncodeError{
+			Input:  hexID,
+			Reason: "ObjectID is not valid hex: " + err.Error(),
+		}
+	}
+
+	return encodeSelfDescribing(KindObjectID, b), nil
+}
+
+// ShortenIPSelfDescribing converts an IP address to a self-describing short ID:
+// the kind character '2' followed by the 16-byte form of the address padded to
+// 22 characters. IPv4 addresses are stored in IPv4-mapped form.
+// Returns an error if ip is not a valid 4- or 16-byte address.
+func ShortenIPSelfDescribing(ip net.IP) (string, error) {
+	ip16 := ip.To16()
+	if ip16 == nil {
+		return "", &EncodeError{
+			Input:  ip.String(),
+			Reason: "IP address must be 4 or 16 bytes",
+		}
+	}
+
+	return encodeSelfDescribing(KindIP, ip16), nil
+}
+
+// DecodeSelfDescribing parses a short ID produced by any of the
+// Shorten*SelfDescribing functions and returns its kind, one of KindUUID,
+// KindObjectID or KindIP, with the raw payload bytes: 16 for UUIDs and IP
+// addresses, 12 for ObjectIDs.
+// Returns a *DecodeError if the kind is unknown or the payload is invalid.
+func DecodeSelfDescribing(s string) (kind int, value []byte, err error) {
+	if s == "" {
+		return 0, nil, &DecodeError{
+			ShortID: s,
+			Reason:  "short ID cannot be empty",
+			Code:    CodeEmpty,
+		}
+	}
+
+	kind = runeIndex(defaultBase62, rune(s[0]))
+	if kind < 0 || kind >= len(selfDescribingKinds) {
+		return 0, nil, &DecodeError{
+			ShortID:  s,
+			Reason:   fmt.Sprintf("unknown kind character '%c'", s[0]),
+			Position: 1,
+			Code:     CodeInvalidChar,
+		}
+	}
+
+	value, err = defaultEncoder.decodeFixed(s[1:], selfDescribingKinds[kind].size)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return kind, value, nil
+}
+
+// encodeSelfDescribing prefixes the fixed-width payload with its kind character
+func encodeSelfDescribing(kind int, payload []byte) string {
+	width := selfDescribingKinds[kind].width
+	return string(defaultBase62[kind]) + defaultEncoder.padLeft(defaultEncoder.encodeBytes(payload), width)
+}
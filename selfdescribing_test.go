@@ -0,0 +1,102 @@
+package shortuuid
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSelfDescribing(t *testing.T) {
+	u := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+	objectID := "507f1f77bcf86cd799439011"
+	objectIDBytes, _ := hex.DecodeString(objectID)
+
+	uuidShort := ShortenUUIDSelfDescribing(u)
+
+	objectIDShort, err := ShortenObjectIDSelfDescribing(objectID)
+	if err != nil {
+		t.Fatalf("Error shortening ObjectID: %v", err)
+	}
+
+	ipv6Short, err := ShortenIPSelfDescribing(net.ParseIP("2001:db8::1"))
+	if err != nil {
+		t.Fatalf("Error shortening IP: %v", err)
+	}
+
+	ipv4Short, err := ShortenIPSelfDescribing(net.ParseIP("192.0.2.1").To4())
+	if err != nil {
+		t.Fatalf("Error shortening IP: %v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		short    string
+		kind     int
+		value    []byte
+		expected string
+	}{
+		{"UUID", uuidShort, KindUUID, u[:], "02XrVqpuNYMfp5OSuawGnL1"},
+		{"ObjectID", objectIDShort, KindObjectID, objectIDBytes, ""},
+		{"IPv6", ipv6Short, KindIP, net.ParseIP("2001:db8::1"), ""},
+		{"IPv4", ipv4Short, KindIP, net.ParseIP("192.0.2.1").To16(), ""},
+		{"nil UUID", ShortenUUIDSelfDescribing(uuid.Nil), KindUUID, uuid.Nil[:], "00000000000000000000000"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expected != "" && tc.short != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, tc.short)
+			}
+
+			kind, value, err := DecodeSelfDescribing(tc.short)
+			if err != nil {
+				t.Fatalf("Error decoding %s: %v", tc.short, err)
+			}
+
+			if kind != tc.kind {
+				t.Errorf("Expected kind %d, got %d", tc.kind, kind)
+			}
+
+			if !bytes.Equal(value, tc.value) {
+				t.Errorf("Expected value %x, got %x", tc.value, value)
+			}
+		})
+	}
+
+	if len(objectIDShort) != 18 {
+		t.Errorf("Expected 18 characters for an ObjectID, got %d in %s", len(objectIDShort), objectIDShort)
+	}
+}
+
+func TestDecodeSelfDescribingInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"3abc",
+		"z2XrVqpuNYMfp5OSuawGnL1",
+		"@2XrVqpuNYMfp5OSuawGnL1",
+		"1zzzzzzzzzzzzzzzzzz",
+		"0",
+	}
+
+	for _, s := range testCases {
+		t.Run(s, func(t *testing.T) {
+			_, _, err := DecodeSelfDescribing(s)
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Errorf("Expected DecodeError, got %T: %v", err, err)
+			}
+		})
+	}
+
+	if _, err := ShortenObjectIDSelfDescribing("not hex"); err == nil {
+		t.Error("Expected error for invalid ObjectID")
+	}
+
+	if _, err := ShortenIPSelfDescribing(net.IP{1, 2, 3}); err == nil {
+		t.Error("Expected error for invalid IP")
+	}
+}
@@ -0,0 +1,59 @@
+package shortuuid
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+)
+
+// seqWidth is the number of base62 characters needed for any uint16
+const seqWidth = 3
+
+// ShortenUUIDSeq packs a UUID and a 16-bit sequence number into one token for
+// hi/lo key allocation. The sequence is encoded as a fixed 3-character prefix
+// and the UUID follows, left-padded to 22 characters, so the token is always 25
+// characters long. Tokens for the same UUID sort by sequence number.
+func ShortenUUIDSeq(u uuid.UUID, seq uint16) (string, error) {
+	short, err := defaultEncoder.ShortenUUID(u)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := defaultEncoder.intToBase(big.NewInt(int64(seq)))
+	return defaultEncoder.padLeft(prefix, seqWidth) + defaultEncoder.padLeft(short, uuidWidth), nil
+}
+
+// ExpandUUIDSeq converts a token created by ShortenUUIDSeq back to its UUID
+// and sequence number.
+// Returns a *DecodeError if the token length is wrong, the sequence exceeds
+// 16 bits, or either part is invalid.
+func ExpandUUIDSeq(s string) (uuid.UUID, uint16, error) {
+	if len(s) != seqWidth+uuidWidth {
+		return uuid.UUID{}, 0, &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("invalid sequence token length: expected %d characters, got %d", seqWidth+uuidWidth, len(s)),
+			Code:    CodeBadLength,
+		}
+	}
+
+	seq, err := DecodeUint64(s[:seqWidth])
+	if err != nil {
+		return uuid.UUID{}, 0, err
+	}
+
+	if seq > 0xffff {
+		return uuid.UUID{}, 0, &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("sequence %d exceeds 16 bits", seq),
+			Code:    CodeOverflow,
+		}
+	}
+
+	u, err := defaultEncoder.ExpandUUID(s[seqWidth:])
+	if err != nil {
+		return uuid.UUID{}, 0, err
+	}
+
+	return u, uint16(seq), nil
+}
@@ -0,0 +1,78 @@
+package shortuuid
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenUUIDSeq(t *testing.T) {
+	u := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+
+	testCases := []struct {
+		seq      uint16
+		expected string
+	}{
+		{0, "0002XrVqpuNYMfp5OSuawGnL1"},
+		{1, "0012XrVqpuNYMfp5OSuawGnL1"},
+		{62, "0102XrVqpuNYMfp5OSuawGnL1"},
+		{1000, "0G82XrVqpuNYMfp5OSuawGnL1"},
+		{65535, "H312XrVqpuNYMfp5OSuawGnL1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprint(tc.seq), func(t *testing.T) {
+			token, err := ShortenUUIDSeq(u, tc.seq)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			if token != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, token)
+			}
+
+			expanded, seq, err := ExpandUUIDSeq(token)
+			if err != nil {
+				t.Fatalf("Error expanding token %s: %v", token, err)
+			}
+
+			if expanded != u || seq != tc.seq {
+				t.Errorf("Expected %s/%d, got %s/%d", u, tc.seq, expanded, seq)
+			}
+		})
+	}
+
+	// Short UUIDs are padded so the split stays fixed
+	token, err := ShortenUUIDSeq(uuid.Nil, 7)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	expanded, seq, err := ExpandUUIDSeq(token)
+	if err != nil || expanded != uuid.Nil || seq != 7 {
+		t.Errorf("Expected nil UUID with sequence 7, got %s/%d (error: %v)", expanded, seq, err)
+	}
+}
+
+func TestExpandUUIDSeqInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"0002XrVqpuNYMfp5OSuawGnL",
+		"H322XrVqpuNYMfp5OSuawGnL1",
+		"zzz2XrVqpuNYMfp5OSuawGnL1",
+		"0@02XrVqpuNYMfp5OSuawGnL1",
+		"000zzzzzzzzzzzzzzzzzzzzzz",
+	}
+
+	for _, token := range testCases {
+		t.Run(token, func(t *testing.T) {
+			_, _, err := ExpandUUIDSeq(token)
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Errorf("Expected DecodeError, got %T: %v", err, err)
+			}
+		})
+	}
+}
@@ -2,15 +2,22 @@
 //
 // The package offers two main approaches:
 //   - Shorten/Expand: For encoding arbitrary strings
-//   - ShortenUUID/ExpandUUID: For encoding UUID objects with optimized hex handling
+//   - ShortenUUID/ExpandUUID: For encoding UUID objects with fixed 128-bit arithmetic
 //
-// All functions use a base62 alphabet (0-9, A-Z, a-z) to create compact, readable identifiers.
+// All functions use a base62 alphabet (0-9, A-Z, a-z) to create compact, readable identifiers;
+// SetDefaultEncoder can swap the encoder behind the core functions it lists.
+// Encoding is pure integer arithmetic over the input bytes, so short IDs are identical
+// across Go versions, operating systems and 32-bit or 64-bit architectures.
+// Built-in Encoder values such as NoLookalikes offer the same operations over other alphabets.
 package shortuuid
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
-	"math/big"
+	"math/rand"
 	"strings"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 )
@@ -18,197 +25,458 @@ import (
 // EncodeError represents an error that occurs during string or UUID encoding.
 // It contains the original input and a description of what went wrong.
 type EncodeError struct {
-	Input  string // The input that failed to encode
-	Reason string // Description of the error
+	Input   string // The input that failed to encode
+	Reason  string // Description of the error
+	Encoder string // Name of the encoder that produced the error, if configured with WithName
 }
 
 func (e *EncodeError) Error() string {
+	if e.Encoder != "" {
+		return fmt.Sprintf("encode error (encoder '%s') for input '%s': %s", e.Encoder, e.Input, e.Reason)
+	}
 	return fmt.Sprintf("encode error for input '%s': %s", e.Input, e.Reason)
 }
 
 // DecodeError represents an error that occurs during short ID decoding.
 // It contains the short ID that failed to decode and a description of the error.
 type DecodeError struct {
-	ShortID string // The short ID that failed to decode
-	Reason  string // Description of the error
+	ShortID  string    // The short ID that failed to decode
+	Reason   string    // Description of the error
+	Encoder  string    // Name of the encoder that produced the error, if configured with WithName
+	Err      error     // Sentinel error classifying the failure, such as ErrTruncated, if any
+	Position int       // 1-based rune position of the offending character, or 0 if not tied to one
+	Code     ErrorCode // Classification of the failure for programmatic handling
 }
 
 func (e *DecodeError) Error() string {
+	if e.Encoder != "" {
+		return fmt.Sprintf("decode error (encoder '%s') for short ID '%s': %s", e.Encoder, e.ShortID, e.Reason)
+	}
 	return fmt.Sprintf("decode error for short ID '%s': %s", e.ShortID, e.Reason)
 }
 
+// Unwrap returns the sentinel error classifying the failure, so that
+// errors.Is(err, ErrTruncated) and similar checks work.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode classifies why a short ID failed to decode, so callers can switch
+// on the cause without matching reason strings.
+type ErrorCode int
+
+const (
+	// CodeUnknown is the zero value, for errors that are not classified
+	CodeUnknown ErrorCode = iota
+	// CodeEmpty means the short ID was empty
+	CodeEmpty
+	// CodeInvalidChar means a character, tag, kind or word is not recognized
+	CodeInvalidChar
+	// CodeBadLength means the short ID or one of its parts has the wrong length
+	CodeBadLength
+	// CodeOverflow means the decoded value is too large for its destination
+	CodeOverflow
+	// CodeReserved means the short ID decodes to a value the encoder refuses
+	CodeReserved
+	// CodeChecksum means an embedded checksum or check character does not match
+	CodeChecksum
+	// CodeInvalidUUID means the decoded bytes are not an acceptable UUID
+	CodeInvalidUUID
+	// CodeMalformed means the token structure, such as a separator or prefix, is wrong
+	CodeMalformed
+	// CodeRoundTrip means an encoder self-test produced a different value
+	CodeRoundTrip
+)
+
+// String returns the name of the code, such as "invalid_char".
+func (c ErrorCode) String() string {
+	switch c {
+	case CodeEmpty:
+		return "empty"
+	case CodeInvalidChar:
+		return "invalid_char"
+	case CodeBadLength:
+		return "bad_length"
+	case CodeOverflow:
+		return "overflow"
+	case CodeReserved:
+		return "reserved"
+	case CodeChecksum:
+		return "checksum"
+	case CodeInvalidUUID:
+		return "invalid_uuid"
+	case CodeMalformed:
+		return "malformed"
+	case CodeRoundTrip:
+		return "round_trip"
+	default:
+		return "unknown"
+	}
+}
+
+// Annotated renders the error for terminal display: the short ID on the first
+// line, a caret under the offending character on the second, and the reason on
+// the last. The caret line is omitted when the error has no Position. Alignment
+// assumes one column per rune, which holds for every built-in alphabet.
+func (e *DecodeError) Annotated() string {
+	var b strings.Builder
+	b.WriteString(e.ShortID)
+	b.WriteByte('\n')
+	if e.Position > 0 {
+		b.WriteString(strings.Repeat(" ", e.Position-1))
+		b.WriteString("^\n")
+	}
+	b.WriteString(e.Reason)
+	return b.String()
+}
+
+// ErrTruncated is wrapped by a DecodeError when a short ID is shorter than the
+// fixed width an encoder in strict-width mode requires.
+var ErrTruncated = errors.New("short ID is truncated")
+
+// ErrUUIDOverflow is wrapped by a DecodeError when a short ID decoded as a UUID
+// represents a value larger than 128 bits, such as an over-long short ID.
+var ErrUUIDOverflow = errors.New("short ID exceeds 128 bits")
+
+// ErrChecksumMismatch is wrapped by a DecodeError when a short ID's embedded
+// checksum does not match its payload, which indicates corruption.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// DefaultAlphabet is the base62 alphabet used by the package-level functions.
+// Changing it would invalidate every stored short ID; see AssertDefaultAlphabet.
+const DefaultAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
 // defaultBase62 is the default alphabet for encoding
 // Uses: '0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz'
-var defaultBase62 = []rune("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
+var defaultBase62 = []rune(DefaultAlphabet)
+
+// uuidWidth is the number of base62 characters needed for any 128-bit value
+const uuidWidth = 22
+
+// ShortNil and ShortMax are the short IDs ShortenUUID produces for uuid.Nil and
+// uuid.Max, for comparing against the sentinels without encoding them. Both
+// round-trip through ExpandUUID. The nil UUID encodes to a single '0' because
+// leading zeros are not padded; encoders configured with WithStrictWidth pad it
+// to 22 characters instead.
+const (
+	ShortNil = "0"
+	ShortMax = "7n42DGM5Tflk9n8mt7Fhc7"
+)
+
+// defaultEncoder is the base62 encoder behind the package-level functions and
+// every fixed-layout format
+var defaultEncoder = newEncoder(defaultBase62, "0-9, A-Z, a-z")
+
+// swappedDefault holds the encoder installed by SetDefaultEncoder, nil for defaultEncoder
+var swappedDefault atomic.Pointer[Encoder]
+
+// activeEncoder returns the encoder behind Shorten, Expand, ShortenUUID and ExpandUUID
+func activeEncoder() *Encoder {
+	if e := swappedDefault.Load(); e != nil {
+		return e
+	}
+	return defaultEncoder
+}
+
+// SetDefaultEncoder replaces the encoder behind exactly these functions:
+// Shorten, Expand, ShortenUUID, ExpandUUID, ExpandAppend, PreviewShorten,
+// ShortenRecord, ExpandUUIDInto, DecodeBoth, ShortenUUIDPadded,
+// ExpandUUIDPadded, NewShort, New, NewV7 and IsValid. A nil e restores the
+// base62 default. The swap is atomic and reads are lock-free, so it is safe to
+// call while other goroutines encode and decode; each call uses either the old
+// or the new encoder throughout. Every
+// other package-level function, including the cursor, slug, grouped and stream
+// helpers, always uses base62.
+func SetDefaultEncoder(e *Encoder) {
+	swappedDefault.Store(e)
+}
+
+// AssertDefaultAlphabet returns an error if the package's default alphabet
+// differs from expected. Callers can pass the alphabet their stored IDs were
+// created with and check it at startup, so that a library upgrade that changed
+// the alphabet fails loudly instead of silently breaking existing IDs.
+func AssertDefaultAlphabet(expected string) error {
+	if actual := string(defaultBase62); actual != expected {
+		return fmt.Errorf("shortuuid: default alphabet mismatch: expected %q, got %q", expected, actual)
+	}
+	return nil
+}
 
 // Shorten converts any string to a short, URL-safe identifier using base62 encoding.
 // The input string is converted to bytes and then encoded using the base62 alphabet.
+// Leading zero bytes are kept as leading '0' characters, so every input,
+// including binary keys that start with NUL, round-trips through Expand.
 // Returns an error if the input string is empty.
 func Shorten(input string) (string, error) {
-	return encodeString(input)
+	return activeEncoder().Shorten(input)
 }
 
 // Expand converts a short ID back to the original string using base62 decoding.
 // The short ID must contain only valid base62 characters (0-9, A-Z, a-z).
-// Returns an error if the short ID contains invalid characters.
+// The original bytes are returned unchanged even if they are not valid UTF-8,
+// so input and output are byte-for-byte identical on every platform.
+// Returns an error if the short ID is empty or contains invalid characters.
 func Expand(shortID string) (string, error) {
-	return decodeString(shortID)
+	return activeEncoder().Expand(shortID)
 }
 
-// ShortenUUID converts a uuid.UUID to a short, URL-safe identifier using optimized hex encoding.
-// This method is more efficient than Shorten for UUID objects as it works directly with
-// the UUID's hex representation rather than converting to string first.
-func ShortenUUID(u uuid.UUID) (string, error) {
-	uuidStr := u.String()
-	if uuidStr == "" {
+// ExpandAppend decodes a short ID like Expand and appends the original bytes
+// to dst, returning the extended slice in the style of strconv.AppendInt.
+// Decoding many short IDs into one reused buffer avoids allocating a string
+// per result. The appended bytes are exactly those Expand would return. On
+// error dst is returned unchanged along with the error.
+func ExpandAppend(dst []byte, shortID string) ([]byte, error) {
+	e := activeEncoder()
+	dst, err := e.appendDecoded(dst, shortID)
+	return dst, e.named(err)
+}
+
+// PreviewShorten reports what Shorten would return for input without creating
+// an error value: valid is false, and short empty, exactly when Shorten would
+// fail, which is only for empty input. Bulk validation that needs just the
+// result and a boolean avoids allocating an error per rejected entry.
+func PreviewShorten(input string) (short string, valid bool) {
+	if input == "" {
+		return "", false
+	}
+
+	return activeEncoder().encodeNonEmpty(input), true
+}
+
+// ShortenRecord converts a fixed-width record to a short ID, like Shorten, after
+// checking that the input is exactly width bytes long. Trailing bytes such as
+// spaces or NULs are significant and survive the round trip through Expand.
+// Returns an error if the input is empty or its length differs from width.
+func ShortenRecord(input string, width int) (string, error) {
+	if len(input) != width {
 		return "", &EncodeError{
-			Input:  uuidStr,
-			Reason: "UUID string cannot be empty",
+			Input:  input,
+			Reason: fmt.Sprintf("record length mismatch: expected %d bytes, got %d", width, len(input)),
 		}
 	}
 
-	// Remove dashes and encode
-	cleanUUID := strings.ReplaceAll(uuidStr, "-", "")
-	return encodeHex(cleanUUID)
+	return activeEncoder().encodeString(input)
 }
 
-// ExpandUUID converts a short ID back to a uuid.UUID object.
-// The short ID must have been created by ShortenUUID to ensure proper UUID format.
-// Returns an error if the short ID is invalid or doesn't decode to a valid UUID.
-func ExpandUUID(shortID string) (uuid.UUID, error) {
-	// Decode the short ID to hex string
-	hexStr, err := decodeHex(shortID)
+// ShortenUUID converts a uuid.UUID to a short, URL-safe identifier.
+// It treats the UUID's 16 bytes as one 128-bit number and encodes it with fixed-width
+// arithmetic on two uint64 halves, which is more efficient than Shorten.
+func ShortenUUID(u uuid.UUID) (string, error) {
+	return activeEncoder().ShortenUUID(u)
+}
+
+// ShortenUUIDStrictWidth converts a uuid.UUID to a short ID like ShortenUUID,
+// but returns an error instead of a shorter ID when the natural encoding is
+// under 22 characters. Note that this is not rare: about one in eight random
+// UUIDs is small enough to encode to 21 characters or fewer. Callers who want
+// a fixed width without errors should use ShortenUUIDPadded instead.
+func ShortenUUIDStrictWidth(u uuid.UUID) (string, error) {
+	short, err := defaultEncoder.ShortenUUID(u)
 	if err != nil {
-		return uuid.UUID{}, err
+		return "", err
 	}
 
-	// Add dashes to create proper UUID format
-	if len(hexStr) != 32 {
-		return uuid.UUID{}, &DecodeError{
-			ShortID: shortID,
-			Reason:  fmt.Sprintf("decoded to invalid length: expected 32 hex characters, got %d", len(hexStr)),
+	if len(short) < uuidWidth {
+		return "", &EncodeError{
+			Input:  u.String(),
+			Reason: fmt.Sprintf("encodes to %d characters, below the fixed width of %d", len(short), uuidWidth),
 		}
 	}
 
-	uuidStr := fmt.Sprintf("%s-%s-%s-%s-%s",
-		hexStr[0:8],
-		hexStr[8:12],
-		hexStr[12:16],
-		hexStr[16:20],
-		hexStr[20:32])
+	return short, nil
+}
+
+// ShortenUUIDPadded converts a uuid.UUID to a short ID like ShortenUUID, left-
+// padded with the alphabet's zero character to the width needed for any
+// 128-bit value: 22 characters with the default base62 alphabet. A check
+// character, if the encoder adds one, follows the padded width. Fixed-width
+// IDs align in columns and sort lexicographically in UUID order.
+func ShortenUUIDPadded(u uuid.UUID) (string, error) {
+	e := activeEncoder()
+	short, err := e.shortenUUID(u, true)
+	return short, e.named(err)
+}
+
+// ExpandUUIDPadded converts a short ID created by ShortenUUIDPadded back to a
+// UUID. Leading zero characters only add zero high bits, so the padding decodes
+// away and unpadded short IDs from ShortenUUID expand to the same UUID.
+// Returns an error like ExpandUUID.
+func ExpandUUIDPadded(shortID string) (uuid.UUID, error) {
+	return activeEncoder().ExpandUUID(shortID)
+}
 
-	parsedUUID, err := uuid.Parse(uuidStr)
+// ShortenUUIDRunes converts a uuid.UUID to a base62 short ID, but returns the
+// characters as a freshly allocated []rune that the caller owns
+// and may modify or reuse.
+func ShortenUUIDRunes(u uuid.UUID) ([]rune, error) {
+	short, err := defaultEncoder.ShortenUUID(u)
 	if err != nil {
-		return uuid.UUID{}, &DecodeError{
-			ShortID: shortID,
-			Reason:  "failed to parse UUID: " + err.Error(),
-		}
+		return nil, err
 	}
 
-	return parsedUUID, nil
+	return []rune(short), nil
 }
 
-// encodeString converts any string to a short ID using base62
-func encodeString(input string) (string, error) {
-	if input == "" {
+// ShortenUUIDLoose parses s as a UUID in any form understood by uuid.Parse
+// (upper or lower case, with or without hyphens, braced or urn:uuid:) and
+// returns its base62 short ID, so that every representation of the same UUID yields
+// the same short ID. Use it instead of Shorten when the input is a UUID string.
+// Returns an *EncodeError if s is not a parseable UUID.
+func ShortenUUIDLoose(s string) (string, error) {
+	u, err := uuid.Parse(s)
+	if err != nil {
 		return "", &EncodeError{
-			Input:  input,
-			Reason: "input string cannot be empty",
+			Input:  s,
+			Reason: "failed to parse UUID: " + err.Error(),
 		}
 	}
 
-	// Convert string to bytes, then to big integer
-	bytes := []byte(input)
-	num := new(big.Int)
-	num.SetBytes(bytes)
+	return defaultEncoder.ShortenUUID(u)
+}
 
-	// Convert to base62
-	return intToBase(num), nil
+// ExpandUUID converts a short ID back to a uuid.UUID object.
+// The short ID must have been created by ShortenUUID to ensure proper UUID format.
+// Returns an error if the short ID is empty, invalid, or doesn't decode to a valid UUID;
+// short IDs whose value exceeds 128 bits return a *DecodeError wrapping ErrUUIDOverflow.
+func ExpandUUID(shortID string) (uuid.UUID, error) {
+	return activeEncoder().ExpandUUID(shortID)
 }
 
-// decodeString converts a short ID back to the original string
-func decodeString(shortID string) (string, error) {
-	// Convert from base to integer
-	num, err := baseToInt(shortID)
+// ExpandUUIDInto decodes a short ID like ExpandUUID, writing the UUID bytes
+// into the caller-owned dst. With the base62 default it does not allocate for
+// valid input, which makes it the lowest-level decode for hot paths. dst is
+// left untouched on error.
+// Returns a *DecodeError if the short ID is empty or invalid, or one wrapping
+// ErrUUIDOverflow if it exceeds 128 bits.
+func ExpandUUIDInto(shortID string, dst *[16]byte) error {
+	e := activeEncoder()
+	u, err := e.expandUUID(shortID)
 	if err != nil {
-		return "", err
+		return e.named(err)
 	}
 
-	// Convert big integer back to bytes, then to string
-	bytes := num.Bytes()
-	return string(bytes), nil
+	*dst = u
+	return nil
 }
 
-// encodeHex converts a hex string to a short ID using base62
-func encodeHex(hexStr string) (string, error) {
-	// Convert hex string to big integer
-	num := new(big.Int)
-	num.SetString(hexStr, 16)
-
-	// Convert to base62
-	return intToBase(num), nil
-}
+// ParseUUIDFlexible accepts either a UUID in any form understood by uuid.Parse
+// or a short ID, and returns the UUID. Short IDs never contain hyphens and are
+// at most 22 characters long, so input that contains a hyphen or is longer than
+// 22 characters is parsed as a UUID; anything else is expanded as a short ID.
+// Returns a *DecodeError if the input is neither.
+func ParseUUIDFlexible(s string) (uuid.UUID, error) {
+	if !strings.Contains(s, "-") && len(s) <= 22 {
+		return defaultEncoder.ExpandUUID(s)
+	}
 
-// decodeHex converts a short ID back to a hex string
-func decodeHex(shortID string) (string, error) {
-	// Convert from base to integer
-	num, err := baseToInt(shortID)
+	parsedUUID, err := uuid.Parse(s)
 	if err != nil {
-		return "", err
+		return uuid.UUID{}, &DecodeError{
+			ShortID: s,
+			Reason:  "failed to parse UUID: " + err.Error(),
+			Code:    CodeInvalidUUID,
+		}
 	}
 
-	// Convert to hex string with proper padding for UUID (32 chars)
-	hexStr := fmt.Sprintf("%032s", num.Text(16))
-	return hexStr, nil
+	return parsedUUID, nil
 }
 
-// intToBase converts a big integer to the target base representation
-func intToBase(num *big.Int) string {
-	if num.Sign() == 0 {
-		return string(defaultBase62[0])
+// ExpandUUIDAuto converts a short ID in either base62 or unpadded base64url
+// (RFC 4648) back to a UUID, to smooth migrations from legacy base64url IDs.
+// Base62 is tried first; base64url is tried only if that fails.
+//
+// The two encodings overlap: a 22-character base64url ID made only of base62
+// characters may also be a valid base62 short ID for a different UUID, in
+// which case the base62 interpretation wins. Use ExpandUUID when the encoding
+// is known.
+func ExpandUUIDAuto(shortID string) (uuid.UUID, error) {
+	u, err := defaultEncoder.ExpandUUID(shortID)
+	if err == nil {
+		return u, nil
 	}
 
-	var result []rune
-	base := big.NewInt(int64(len(defaultBase62)))
-	zero := big.NewInt(0)
+	b, b64Err := base64.RawURLEncoding.DecodeString(shortID)
+	if b64Err != nil || len(b) != 16 {
+		return uuid.UUID{}, err
+	}
 
-	// Make a copy to avoid modifying the original
-	n := new(big.Int).Set(num)
+	return uuid.UUID(b), nil
+}
 
-	for n.Cmp(zero) > 0 {
-		remainder := new(big.Int)
-		n.DivMod(n, base, remainder)
-		result = append([]rune{defaultBase62[remainder.Int64()]}, result...)
+// DecodeBoth decodes a short ID and returns both interpretations of it, so the
+// caller can decide which applies: asString is what Expand returns, and asUUID
+// is what ExpandUUID returns. uuidValid reports whether ExpandUUID succeeds,
+// which with the base62 default means the value fits in 128 bits; when it is
+// false asUUID is the nil UUID.
+// Like ExpandUUID, values shorter than 16 bytes are valid UUIDs with leading
+// zero bytes, so uuidValid alone does not prove the ID came from ShortenUUID.
+// Returns a *DecodeError if the short ID is empty or contains invalid characters.
+func DecodeBoth(shortID string) (asString string, asUUID uuid.UUID, uuidValid bool, err error) {
+	e := activeEncoder()
+	num, err := e.baseToInt(shortID)
+	if err != nil {
+		return "", uuid.UUID{}, false, err
 	}
 
-	return string(result)
+	asString = string(e.appendValue(nil, num, shortID))
+	asUUID, uuidErr := e.expandUUID(shortID)
+	return asString, asUUID, uuidErr == nil, nil
 }
 
-// baseToInt converts a base representation back to a big integer
-func baseToInt(encoded string) (*big.Int, error) {
-	result := big.NewInt(0)
-	base := big.NewInt(int64(len(defaultBase62)))
+// Canonicalize returns the unique minimal form of a short ID by decoding and
+// re-encoding its integer value, which strips leading zero glyphs ('0').
+// Short IDs that differ only by such glyphs canonicalize to the same string.
+// The glyphs are padding for ExpandUUID, but Expand decodes each to a leading
+// zero byte, so canonicalizing a short ID from Shorten can change what Expand
+// returns.
+// Returns a *DecodeError if the short ID contains invalid characters.
+func Canonicalize(shortID string) (string, error) {
+	num, err := defaultEncoder.baseToInt(shortID)
+	if err != nil {
+		return "", err
+	}
 
-	for _, char := range encoded {
-		// Find the character in the alphabet
-		index := -1
-		for i, alphabetChar := range defaultBase62 {
-			if char == alphabetChar {
-				index = i
-				break
-			}
-		}
+	return defaultEncoder.intToBase(num), nil
+}
 
-		if index == -1 {
-			return nil, &DecodeError{
-				ShortID: encoded,
-				Reason:  fmt.Sprintf("invalid character '%c' in short ID (valid characters: 0-9, A-Z, a-z)", char),
-			}
-		}
+// ExpandVerbose converts a short ID back to the original string like Expand,
+// and also reports how many leading zero glyphs ('0') it carried beyond the
+// canonical form, i.e. how many characters Canonicalize would strip. Expand
+// decodes each leading zero glyph to a zero byte, so the result starts with
+// that many zero bytes or, for an ID of only zero glyphs, one more. Migration
+// tools can use the count to find non-canonical IDs in a dataset.
+func ExpandVerbose(shortID string) (result string, leadingZeroGlyphs int, err error) {
+	result, err = defaultEncoder.Expand(shortID)
+	if err != nil {
+		return "", 0, err
+	}
 
-		result.Mul(result, base)
-		result.Add(result, big.NewInt(int64(index)))
+	trimmed := strings.TrimLeft(shortID, string(defaultBase62[0]))
+	if trimmed == "" {
+		// The zero value itself keeps a single glyph
+		trimmed = string(defaultBase62[0])
 	}
 
-	return result, nil
+	return result, len(shortID) - len(trimmed), nil
+}
+
+// ShortFromSeed deterministically derives a version 4 UUID from seed and returns
+// its short ID. The same seed always yields the same short ID across runs and
+// machines, which makes it suitable for fixtures and demos. It uses a private
+// seeded generator and never touches global randomness. Do not use it for real IDs.
+func ShortFromSeed(seed int64) string {
+	// rand.Rand.Read never fails, so neither does NewRandomFromReader
+	u, _ := uuid.NewRandomFromReader(rand.New(rand.NewSource(seed)))
+	short, _ := defaultEncoder.ShortenUUID(u)
+	return short
+}
+
+// runeIndex returns the position of char in alphabet, or -1 if it is not present
+func runeIndex(alphabet []rune, char rune) int {
+	for i, alphabetChar := range alphabet {
+		if char == alphabetChar {
+			return i
+		}
+	}
+	return -1
 }
@@ -3,6 +3,9 @@ package shortuuid
 import (
 	"errors"
 	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/uuid"
@@ -66,99 +69,1038 @@ func TestCompatibility(t *testing.T) {
 	}
 }
 
+func TestCrossPlatformStability(t *testing.T) {
+	// Pinned vectors computed independently of Go; CI runs them on 32-bit and
+	// 64-bit targets (GOARCH=386 go test) to catch word-size dependence
+	stringCases := []struct {
+		input    string
+		expected string
+	}{
+		{"\xff\xfe\xfd", "18OS5"},
+		{"a\xc3(b", "1n01h4"},
+		{"\xed\xa0\x80", "13LHM"},
+		{strings.Repeat("\xff", 32), "yhjskwdA6OZ1AL1YmHWZWm8LLG7HjnuCA2j5rOw8Xp1"},
+	}
+
+	for _, tc := range stringCases {
+		t.Run(fmt.Sprintf("%q", tc.input), func(t *testing.T) {
+			short, err := Shorten(tc.input)
+			if err != nil {
+				t.Fatalf("Error shortening string: %v", err)
+			}
+
+			if short != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, short)
+			}
+
+			// Invalid UTF-8 must come back byte for byte, not as U+FFFD
+			expanded, err := Expand(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != tc.input {
+				t.Errorf("Expected %q, got %q", tc.input, expanded)
+			}
+		})
+	}
+
+	uuidCases := map[string]string{
+		"00000000-0000-0000-0000-000000000001": "1",
+		"ffffffff-ffff-ffff-ffff-fffffffffffe": "7n42DGM5Tflk9n8mt7Fhc6",
+		"0123abcd-4567-89ef-0123-456789abcdef": "29IEO5GNoDBfn3bfXmhP5",
+	}
+
+	for input, expected := range uuidCases {
+		short, err := ShortenUUID(uuid.MustParse(input))
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", input, err)
+		}
+
+		if short != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, input, short)
+		}
+	}
+}
+
+func TestDefaultAlphabet(t *testing.T) {
+	// Guard against accidental changes: every stored short ID depends on this alphabet
+	const pinned = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	if DefaultAlphabet != pinned {
+		t.Fatalf("Default alphabet changed: expected %q, got %q", pinned, DefaultAlphabet)
+	}
+
+	if err := AssertDefaultAlphabet(pinned); err != nil {
+		t.Errorf("Expected matching alphabet to pass, got %v", err)
+	}
+
+	mismatched := "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	err := AssertDefaultAlphabet(mismatched)
+	if err == nil {
+		t.Fatal("Expected mismatched alphabet to fail")
+	}
+
+	if !strings.Contains(err.Error(), "default alphabet mismatch") {
+		t.Errorf("Expected mismatch message, got %q", err.Error())
+	}
+}
+
 func TestShortenUUIDCompatibility(t *testing.T) {
 	// Test that ShortenUUID produces the same results as Shorten
 	// This demonstrates the proper usage: if you have a UUID object, use ShortenUUID
 	testCases := map[string]string{
 		"53a8d1b9-4eca-4888-9b59-8fa91497857b": "2XrVqpuNYMfp5OSuawGnL1",
-		"8658bb57-992d-4a4d-9292-a5b118d28c8b": "45VWNy74cXYBydTM0JO3rv",
-		"d26abc73-a6bf-49c6-984d-e08c941fad4a": "6P3AMn3h7r9JJSeHECCjJS",
+		"8658bb57-992d-4a4d-9292-a5b118d28c8b": "45VWNy74cXYBydTM0JO3rv",
+		"d26abc73-a6bf-49c6-984d-e08c941fad4a": "6P3AMn3h7r9JJSeHECCjJS",
+	}
+
+	for uuidStr, expectedShort := range testCases {
+		t.Run(uuidStr, func(t *testing.T) {
+			// Parse UUID string into uuid.UUID
+			parsedUUID, err := uuid.Parse(uuidStr)
+			if err != nil {
+				t.Fatalf("Error parsing UUID %s: %v", uuidStr, err)
+			}
+
+			// Test shortening with ShortenUUID (uuid.UUID API)
+			actualShort, err := ShortenUUID(parsedUUID)
+			if err != nil {
+				t.Fatalf("Error shortening UUID %s: %v", uuidStr, err)
+			}
+
+			if actualShort != expectedShort {
+				t.Errorf("Expected short ID %s, got %s", expectedShort, actualShort)
+			}
+
+			// Test expanding back to UUID
+			expandedUUID, err := ExpandUUID(actualShort)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", actualShort, err)
+			}
+
+			if expandedUUID != parsedUUID {
+				t.Errorf("Expected UUID %s, got %s", parsedUUID, expandedUUID)
+			}
+
+			t.Logf("✓ uuid.UUID: %s -> Short: %s -> uuid.UUID: %s", parsedUUID, actualShort, expandedUUID)
+		})
+	}
+}
+
+func TestShorten(t *testing.T) {
+	// Test basic shortening and expanding with arbitrary strings
+	testStrings := []string{
+		"hello world",
+		"test string 123",
+		"special chars: !@#$%^&*()",
+		"unicode: 你好世界",
+		"email@example.com",
+		"https://example.com/path?query=value",
+	}
+
+	for _, input := range testStrings {
+		t.Run(input, func(t *testing.T) {
+			short, err := Shorten(input)
+			if err != nil {
+				t.Fatalf("Error shortening string '%s': %v", input, err)
+			}
+
+			expanded, err := Expand(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID '%s': %v", short, err)
+			}
+
+			if expanded != input {
+				t.Errorf("Expected '%s', got '%s'", input, expanded)
+			}
+
+			t.Logf("'%s' -> '%s' -> '%s'", input, short, expanded)
+		})
+	}
+}
+
+func TestPreviewShorten(t *testing.T) {
+	for _, input := range []string{"hello world", "a", "\xff\xfe", "08f057f3-23e0-4b2a-8703-03f2dab8f628"} {
+		expected, err := Shorten(input)
+		if err != nil {
+			t.Fatalf("Error shortening string: %v", err)
+		}
+
+		short, valid := PreviewShorten(input)
+		if !valid || short != expected {
+			t.Errorf("Expected %s and valid for %q, got %s and %t", expected, input, short, valid)
+		}
+	}
+
+	short, valid := PreviewShorten("")
+	if valid || short != "" {
+		t.Errorf("Expected empty input to be invalid, got %q and %t", short, valid)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = PreviewShorten("")
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations for invalid input, got %.1f", allocs)
+	}
+}
+
+func TestTrailingBytesPreserved(t *testing.T) {
+	// Trailing bytes end up in the low-order digits, so they must survive the round trip
+	testStrings := []string{
+		"record   ",
+		"record\x00",
+		"record\x00\x00\x00",
+		"a \x00 \x00",
+		" ",
+	}
+
+	for _, input := range testStrings {
+		t.Run(fmt.Sprintf("%q", input), func(t *testing.T) {
+			short, err := Shorten(input)
+			if err != nil {
+				t.Fatalf("Error shortening %q: %v", input, err)
+			}
+
+			expanded, err := Expand(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != input {
+				t.Errorf("Expected %q, got %q", input, expanded)
+			}
+		})
+	}
+}
+
+func TestShortenRecord(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		width   int
+		wantErr bool
+	}{
+		{"exact width", "ABC123", 6, false},
+		{"trailing spaces", "ABC   ", 6, false},
+		{"trailing nulls", "ABC\x00\x00\x00", 6, false},
+		{"too short", "ABC", 6, true},
+		{"too long", "ABC1234", 6, true},
+		{"empty", "", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			short, err := ShortenRecord(tc.input, tc.width)
+			if tc.wantErr {
+				var encodeErr *EncodeError
+				if !errors.As(err, &encodeErr) {
+					t.Fatalf("Expected EncodeError, got %T: %v", err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error shortening record %q: %v", tc.input, err)
+			}
+
+			expanded, err := Expand(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != tc.input {
+				t.Errorf("Expected %q, got %q", tc.input, expanded)
+			}
+		})
+	}
+}
+
+func TestShortenUUID(t *testing.T) {
+	// Test ShortenUUID and ExpandUUID with uuid.UUID types
+	testUUID := uuid.New()
+
+	short, err := ShortenUUID(testUUID)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	expanded, err := ExpandUUID(short)
+	if err != nil {
+		t.Fatalf("Error expanding short ID: %v", err)
+	}
+
+	if expanded != testUUID {
+		t.Errorf("Expected %s, got %s", testUUID, expanded)
+	}
+
+	t.Logf("UUID: %s -> Short: %s -> UUID: %s", testUUID, short, expanded)
+}
+
+func TestSentinelShortIDs(t *testing.T) {
+	testCases := map[string]uuid.UUID{
+		ShortNil: uuid.Nil,
+		ShortMax: uuid.Max,
+	}
+
+	for expected, sentinel := range testCases {
+		t.Run(sentinel.String(), func(t *testing.T) {
+			short, err := ShortenUUID(sentinel)
+			if err != nil {
+				t.Fatalf("Error shortening UUID: %v", err)
+			}
+
+			if short != expected {
+				t.Errorf("Expected %s, got %s", expected, short)
+			}
+
+			expanded, err := ExpandUUID(expected)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", expected, err)
+			}
+
+			if expanded != sentinel {
+				t.Errorf("Expected %s, got %s", sentinel, expanded)
+			}
+		})
+	}
+}
+
+func TestShortenUUIDStrictWidth(t *testing.T) {
+	normal := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+	short, err := ShortenUUIDStrictWidth(normal)
+	if err != nil {
+		t.Fatalf("Error shortening UUID %s: %v", normal, err)
+	}
+
+	if short != "2XrVqpuNYMfp5OSuawGnL1" {
+		t.Errorf("Expected 2XrVqpuNYMfp5OSuawGnL1, got %s", short)
+	}
+
+	for _, input := range []string{"1d152c86-c436-4d47-9269-006c7469b867", "00000000-0000-0000-0000-000000000001"} {
+		_, err := ShortenUUIDStrictWidth(uuid.MustParse(input))
+		var encodeErr *EncodeError
+		if !errors.As(err, &encodeErr) {
+			t.Errorf("Expected EncodeError for %s, got %T: %v", input, err, err)
+		}
+	}
+}
+
+func TestShortenUUIDRunes(t *testing.T) {
+	testUUID := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+
+	runes, err := ShortenUUIDRunes(testUUID)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if string(runes) != "Grm6f7QVJVuVrufEOTgIC" {
+		t.Errorf("Expected Grm6f7QVJVuVrufEOTgIC, got %s", string(runes))
+	}
+
+	// Modifying the slice must not leak into subsequent calls
+	for i := range runes {
+		runes[i] = 'x'
+	}
+
+	again, err := ShortenUUIDRunes(testUUID)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if string(again) != "Grm6f7QVJVuVrufEOTgIC" {
+		t.Errorf("Expected Grm6f7QVJVuVrufEOTgIC after modification, got %s", string(again))
+	}
+}
+
+func TestShortenUUIDLoose(t *testing.T) {
+	testCases := []string{
+		"08f057f3-23e0-4b2a-8703-03f2dab8f628",
+		"08F057F3-23E0-4B2A-8703-03F2DAB8F628",
+		"08f057f323e04b2a870303f2dab8f628",
+		"08F057F323E04B2A870303F2DAB8F628",
+		"{08f057f3-23e0-4b2a-8703-03f2dab8f628}",
+		"{08F057F3-23E0-4B2A-8703-03F2DAB8F628}",
+		"urn:uuid:08f057f3-23e0-4b2a-8703-03f2dab8f628",
+		"urn:uuid:08F057F3-23E0-4B2A-8703-03F2DAB8F628",
+	}
+
+	for _, input := range testCases {
+		t.Run(input, func(t *testing.T) {
+			short, err := ShortenUUIDLoose(input)
+			if err != nil {
+				t.Fatalf("Error shortening %s: %v", input, err)
+			}
+
+			if short != "Grm6f7QVJVuVrufEOTgIC" {
+				t.Errorf("Expected Grm6f7QVJVuVrufEOTgIC, got %s", short)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "hello world", "08f057f3-23e0-4b2a-8703"} {
+		_, err := ShortenUUIDLoose(input)
+		var encodeErr *EncodeError
+		if !errors.As(err, &encodeErr) {
+			t.Errorf("Expected EncodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+}
+
+func TestExpandAppend(t *testing.T) {
+	inputs := []string{"hello world", "a", "\xff\xfe binary", "日本語"}
+
+	var buf []byte
+	for _, input := range inputs {
+		short, err := Shorten(input)
+		if err != nil {
+			t.Fatalf("Error shortening %q: %v", input, err)
+		}
+
+		buf, err = ExpandAppend(buf, short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+	}
+
+	if want := strings.Join(inputs, ""); string(buf) != want {
+		t.Errorf("Expected %q, got %q", want, buf)
+	}
+
+	// A failed decode leaves the buffer untouched
+	before := len(buf)
+	buf, err := ExpandAppend(buf, "invalid@chars")
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError, got %T: %v", err, err)
+	}
+
+	if len(buf) != before {
+		t.Errorf("Expected %d bytes after failed decode, got %d", before, len(buf))
+	}
+}
+
+func TestSetDefaultEncoder(t *testing.T) {
+	t.Cleanup(func() { SetDefaultEncoder(nil) })
+
+	u := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+	lookalikeShort, err := NoLookalikes.ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	SetDefaultEncoder(NoLookalikes)
+	short, err := ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != lookalikeShort {
+		t.Errorf("Expected %s, got %s", lookalikeShort, short)
+	}
+
+	// Fixed-layout formats keep using base62
+	pair, err := ShortenUUIDPair(u, u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID pair: %v", err)
+	}
+
+	if want := "0Grm6f7QVJVuVrufEOTgIC0Grm6f7QVJVuVrufEOTgIC"; pair != want {
+		t.Errorf("Expected %s, got %s", want, pair)
+	}
+
+	SetDefaultEncoder(nil)
+	short, err = ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != "Grm6f7QVJVuVrufEOTgIC" {
+		t.Errorf("Expected Grm6f7QVJVuVrufEOTgIC after restoring the default, got %s", short)
+	}
+}
+
+func TestSetDefaultEncoderFixedLayouts(t *testing.T) {
+	t.Cleanup(func() { SetDefaultEncoder(nil) })
+
+	// Same glyphs as base62 in a different order, so every ID still parses
+	reordered, err := NewEncoder("1032547698" + DefaultAlphabet[10:])
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+	SetDefaultEncoder(reordered)
+
+	u := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+	scattered, err := ExpandUUIDScattered(ShortenUUIDScattered(u))
+	if err != nil {
+		t.Fatalf("Error expanding scattered short ID: %v", err)
+	}
+	if scattered != u {
+		t.Errorf("Expected %s, got %s", u, scattered)
+	}
+
+	v1 := newV1(0x01d2_3456_789a_bcde)
+	short, err := ShortenUUIDv1Sortable(v1)
+	if err != nil {
+		t.Fatalf("Error shortening v1 UUID: %v", err)
+	}
+	sortable, err := ExpandUUIDv1Sortable(short)
+	if err != nil {
+		t.Fatalf("Error expanding v1 sortable short ID: %v", err)
+	}
+	if sortable != v1 {
+		t.Errorf("Expected %s, got %s", v1, sortable)
+	}
+
+	// Wrappers outside the documented list stay base62
+	if cursor := NewCursor(u).String(); cursor != "2XrVqpuNYMfp5OSuawGnL1" {
+		t.Errorf("Expected cursor 2XrVqpuNYMfp5OSuawGnL1, got %s", cursor)
+	}
+	slugged, _, err := ExpandUUIDWithSlug("2XrVqpuNYMfp5OSuawGnL1-title")
+	if err != nil {
+		t.Fatalf("Error expanding slugged ID: %v", err)
+	}
+	if slugged != u {
+		t.Errorf("Expected %s, got %s", u, slugged)
+	}
+}
+
+func TestSetDefaultEncoderOptions(t *testing.T) {
+	t.Cleanup(func() { SetDefaultEncoder(nil) })
+
+	descending, err := NewEncoder(DefaultAlphabet, WithDescendingSort())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+	checksummed, err := NewEncoder(DefaultAlphabet, WithChecksum(Mod62Checksum))
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	testCases := []struct {
+		name  string
+		e     *Encoder
+		width int
+	}{
+		{"descending", descending, 22},
+		{"checksum", checksummed, 23},
+	}
+
+	uuids := []uuid.UUID{
+		uuid.MustParse("00000000-0000-0000-0000-00000000002a"),
+		uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"),
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			SetDefaultEncoder(tc.e)
+
+			for _, u := range uuids {
+				short, err := ShortenUUID(u)
+				if err != nil {
+					t.Fatalf("Error shortening UUID: %v", err)
+				}
+
+				var dst [16]byte
+				if err := ExpandUUIDInto(short, &dst); err != nil {
+					t.Fatalf("Error expanding %s into buffer: %v", short, err)
+				}
+				if uuid.UUID(dst) != u {
+					t.Errorf("Expected %s, got %s", u, uuid.UUID(dst))
+				}
+
+				_, asUUID, uuidValid, err := DecodeBoth(short)
+				if err != nil {
+					t.Fatalf("Error decoding %s: %v", short, err)
+				}
+				if !uuidValid || asUUID != u {
+					t.Errorf("Expected %s to be valid, got %s (valid %t)", u, asUUID, uuidValid)
+				}
+
+				padded, err := ShortenUUIDPadded(u)
+				if err != nil {
+					t.Fatalf("Error shortening padded UUID: %v", err)
+				}
+				if len(padded) != tc.width {
+					t.Errorf("Expected %d characters, got %d in %s", tc.width, len(padded), padded)
+				}
+
+				expanded, err := ExpandUUIDPadded(padded)
+				if err != nil {
+					t.Fatalf("Error expanding padded %s: %v", padded, err)
+				}
+				if expanded != u {
+					t.Errorf("Expected %s, got %s", u, expanded)
+				}
+			}
+		})
+	}
+}
+
+func TestSetDefaultEncoderConcurrent(t *testing.T) {
+	t.Cleanup(func() { SetDefaultEncoder(nil) })
+
+	u := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+	lookalikeShort, err := NoLookalikes.ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				// Each call sees one encoder or the other, never a mix
+				short, err := ShortenUUID(u)
+				if err != nil || (short != "Grm6f7QVJVuVrufEOTgIC" && short != lookalikeShort) {
+					errs <- fmt.Errorf("unexpected short ID %q: %v", short, err)
+					return
+				}
+
+				// The base62 ID contains 'I', which NoLookalikes rejects
+				expanded, err := ExpandUUID("Grm6f7QVJVuVrufEOTgIC")
+				var decodeErr *DecodeError
+				if (err == nil && expanded != u) || (err != nil && (!errors.As(err, &decodeErr) || decodeErr.Code != CodeInvalidChar)) {
+					errs <- fmt.Errorf("unexpected expansion %s: %v", expanded, err)
+					return
+				}
+			}
+		}()
+	}
+
+	for j := 0; j < 500; j++ {
+		if j%2 == 0 {
+			SetDefaultEncoder(NoLookalikes)
+		} else {
+			SetDefaultEncoder(nil)
+		}
+	}
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestShortenLeadingZeroBytes(t *testing.T) {
+	testCases := map[string]string{
+		"\x00":         "0",
+		"\x00\x00":     "00",
+		"\x00\x00x":    "00" + mustShorten(t, "x"),
+		"\x00abc":      "0" + mustShorten(t, "abc"),
+		"\x00\xff\x00": "0" + mustShorten(t, "\xff\x00"),
+	}
+
+	for input, want := range testCases {
+		t.Run(fmt.Sprintf("%q", input), func(t *testing.T) {
+			short := mustShorten(t, input)
+			if short != want {
+				t.Errorf("Expected %s, got %s", want, short)
+			}
+
+			expanded, err := Expand(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != input {
+				t.Errorf("Expected %q, got %q", input, expanded)
+			}
+		})
+	}
+
+	// Random binary keys with one to four leading zero bytes
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		key := make([]byte, 1+i%4+rng.Intn(16))
+		rng.Read(key[1+i%4:])
+
+		short := mustShorten(t, string(key))
+		expanded, err := Expand(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != string(key) {
+			t.Errorf("Expected %x, got %x", key, expanded)
+		}
+	}
+}
+
+// mustShorten shortens input, failing the test on error
+func mustShorten(t *testing.T, input string) string {
+	t.Helper()
+	short, err := Shorten(input)
+	if err != nil {
+		t.Fatalf("Error shortening %q: %v", input, err)
+	}
+	return short
+}
+
+func TestShortenUUIDPadded(t *testing.T) {
+	testCases := map[string]string{
+		"00000000-0000-0000-0000-000000000000": "0000000000000000000000",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff": "7n42DGM5Tflk9n8mt7Fhc7",
+		"1d152c86-c436-4d47-9269-006c7469b867": "0ssS9A1oUhTFAbdjd6w93P",
+		"53a8d1b9-4eca-4888-9b59-8fa91497857b": "2XrVqpuNYMfp5OSuawGnL1",
 	}
 
-	for uuidStr, expectedShort := range testCases {
-		t.Run(uuidStr, func(t *testing.T) {
-			// Parse UUID string into uuid.UUID
-			parsedUUID, err := uuid.Parse(uuidStr)
+	for input, want := range testCases {
+		t.Run(input, func(t *testing.T) {
+			u := uuid.MustParse(input)
+
+			short, err := ShortenUUIDPadded(u)
 			if err != nil {
-				t.Fatalf("Error parsing UUID %s: %v", uuidStr, err)
+				t.Fatalf("Error shortening UUID: %v", err)
 			}
 
-			// Test shortening with ShortenUUID (uuid.UUID API)
-			actualShort, err := ShortenUUID(parsedUUID)
+			if short != want {
+				t.Errorf("Expected %s, got %s", want, short)
+			}
+
+			// Padded and unpadded forms expand to the same UUID
+			unpadded, err := ShortenUUID(u)
 			if err != nil {
-				t.Fatalf("Error shortening UUID %s: %v", uuidStr, err)
+				t.Fatalf("Error shortening UUID: %v", err)
 			}
 
-			if actualShort != expectedShort {
-				t.Errorf("Expected short ID %s, got %s", expectedShort, actualShort)
+			for _, shortID := range []string{short, unpadded} {
+				expanded, err := ExpandUUIDPadded(shortID)
+				if err != nil {
+					t.Fatalf("Error expanding short ID %s: %v", shortID, err)
+				}
+
+				if expanded != u {
+					t.Errorf("Expected %s, got %s", u, expanded)
+				}
 			}
+		})
+	}
+}
 
-			// Test expanding back to UUID
-			expandedUUID, err := ExpandUUID(actualShort)
+func TestExpandVerbose(t *testing.T) {
+	short, err := Shorten("hello world")
+	if err != nil {
+		t.Fatalf("Error shortening string: %v", err)
+	}
+
+	testCases := []struct {
+		shortID   string
+		expanded  string
+		zeroCount int
+	}{
+		{short, "hello world", 0},
+		{"0" + short, "\x00hello world", 1},
+		{"000" + short, "\x00\x00\x00hello world", 3},
+		{"0", "\x00", 0},
+		{"0000", "\x00\x00\x00\x00", 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.shortID, func(t *testing.T) {
+			expanded, zeroCount, err := ExpandVerbose(tc.shortID)
 			if err != nil {
-				t.Fatalf("Error expanding short ID %s: %v", actualShort, err)
+				t.Fatalf("Error expanding %s: %v", tc.shortID, err)
 			}
 
-			if expandedUUID != parsedUUID {
-				t.Errorf("Expected UUID %s, got %s", parsedUUID, expandedUUID)
+			if expanded != tc.expanded {
+				t.Errorf("Expected %q, got %q", tc.expanded, expanded)
 			}
 
-			t.Logf("✓ uuid.UUID: %s -> Short: %s -> uuid.UUID: %s", parsedUUID, actualShort, expandedUUID)
+			if zeroCount != tc.zeroCount {
+				t.Errorf("Expected %d leading zero glyphs, got %d", tc.zeroCount, zeroCount)
+			}
 		})
 	}
+
+	_, _, err = ExpandVerbose("00@")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError, got %T: %v", err, err)
+	}
 }
 
-func TestShorten(t *testing.T) {
-	// Test basic shortening and expanding with arbitrary strings
-	testStrings := []string{
-		"hello world",
-		"test string 123",
-		"special chars: !@#$%^&*()",
-		"unicode: 你好世界",
-		"email@example.com",
-		"https://example.com/path?query=value",
+func TestShortFromSeed(t *testing.T) {
+	// The same seed must always produce the same short ID
+	for _, seed := range []int64{0, 1, 42, -7} {
+		first := ShortFromSeed(seed)
+		for i := 0; i < 10; i++ {
+			if got := ShortFromSeed(seed); got != first {
+				t.Fatalf("Expected %s for seed %d, got %s", first, seed, got)
+			}
+		}
+
+		expanded, err := ExpandUUID(first)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", first, err)
+		}
+
+		if expanded.Version() != 4 {
+			t.Errorf("Expected version 4 for seed %d, got %d", seed, expanded.Version())
+		}
 	}
 
-	for _, input := range testStrings {
-		t.Run(input, func(t *testing.T) {
-			short, err := Shorten(input)
+	// Pinned value guards against drift across Go versions and platforms
+	if got := ShortFromSeed(42); got != "2XeZPFY5mmWNI0wR7IgXxX" {
+		t.Errorf("Expected 2XeZPFY5mmWNI0wR7IgXxX for seed 42, got %s", got)
+	}
+
+	if ShortFromSeed(1) == ShortFromSeed(2) {
+		t.Error("Expected different seeds to produce different short IDs")
+	}
+}
+
+func TestExpandUUIDInto(t *testing.T) {
+	testCases := []uuid.UUID{
+		uuid.Nil,
+		uuid.Max,
+		uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"),
+		uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628"),
+		uuid.New(),
+	}
+
+	for _, u := range testCases {
+		t.Run(u.String(), func(t *testing.T) {
+			short, err := ShortenUUID(u)
 			if err != nil {
-				t.Fatalf("Error shortening string '%s': %v", input, err)
+				t.Fatalf("Error shortening UUID: %v", err)
 			}
 
-			expanded, err := Expand(short)
-			if err != nil {
-				t.Fatalf("Error expanding short ID '%s': %v", short, err)
+			var dst [16]byte
+			if err := ExpandUUIDInto(short, &dst); err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
 			}
 
-			if expanded != input {
-				t.Errorf("Expected '%s', got '%s'", input, expanded)
+			if uuid.UUID(dst) != u {
+				t.Errorf("Expected %s, got %s", u, uuid.UUID(dst))
 			}
 
-			t.Logf("'%s' -> '%s' -> '%s'", input, short, expanded)
+			allocs := testing.AllocsPerRun(100, func() {
+				_ = ExpandUUIDInto(short, &dst)
+			})
+			if allocs != 0 {
+				t.Errorf("Expected no allocations, got %.1f", allocs)
+			}
 		})
 	}
 }
 
-func TestShortenUUID(t *testing.T) {
-	// Test ShortenUUID and ExpandUUID with uuid.UUID types
-	testUUID := uuid.New()
+func TestExpandUUIDIntoInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"2XrVqpuNYMfp5OSu@wGnL1",
+		"7n42DGM5Tflk9n8mt7Fhc8",
+		"zzzzzzzzzzzzzzzzzzzzzz",
+		"1000000000000000000000000",
+	}
 
-	short, err := ShortenUUID(testUUID)
-	if err != nil {
-		t.Fatalf("Error shortening UUID: %v", err)
+	for _, shortID := range testCases {
+		t.Run(shortID, func(t *testing.T) {
+			dst := [16]byte{1, 2, 3}
+			err := ExpandUUIDInto(shortID, &dst)
+
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+			}
+
+			if dst != [16]byte{1, 2, 3} {
+				t.Errorf("Expected dst to be untouched, got %x", dst)
+			}
+		})
 	}
+}
 
-	expanded, err := ExpandUUID(short)
+func TestExpandUUIDOverflow(t *testing.T) {
+	testCases := []string{
+		"7n42DGM5Tflk9n8mt7Fhc8",
+		"zzzzzzzzzzzzzzzzzzzzzz",
+		"10000000000000000000000",
+		"2XrVqpuNYMfp5OSuawGnL1x",
+	}
+
+	for _, shortID := range testCases {
+		t.Run(shortID, func(t *testing.T) {
+			_, err := ExpandUUID(shortID)
+			if !errors.Is(err, ErrUUIDOverflow) {
+				t.Errorf("Expected ErrUUIDOverflow from ExpandUUID, got %v", err)
+			}
+
+			var dst [16]byte
+			if err := ExpandUUIDInto(shortID, &dst); !errors.Is(err, ErrUUIDOverflow) {
+				t.Errorf("Expected ErrUUIDOverflow from ExpandUUIDInto, got %v", err)
+			}
+		})
+	}
+
+	// The largest UUID still decodes, and leading zero glyphs do not overflow
+	for _, shortID := range []string{ShortMax, "000" + ShortMax} {
+		expanded, err := ExpandUUID(shortID)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", shortID, err)
+		}
+
+		if expanded != uuid.Max {
+			t.Errorf("Expected %s, got %s", uuid.Max, expanded)
+		}
+	}
+}
+
+func TestParseUUIDFlexible(t *testing.T) {
+	expected := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+
+	testCases := []string{
+		"08f057f3-23e0-4b2a-8703-03f2dab8f628",
+		"08F057F3-23E0-4B2A-8703-03F2DAB8F628",
+		"08f057f323e04b2a870303f2dab8f628",
+		"{08f057f3-23e0-4b2a-8703-03f2dab8f628}",
+		"Grm6f7QVJVuVrufEOTgIC",
+	}
+
+	for _, input := range testCases {
+		t.Run(input, func(t *testing.T) {
+			parsed, err := ParseUUIDFlexible(input)
+			if err != nil {
+				t.Fatalf("Error parsing %s: %v", input, err)
+			}
+
+			if parsed != expected {
+				t.Errorf("Expected %s, got %s", expected, parsed)
+			}
+		})
+	}
+
+	for _, input := range []string{"08f057f3-23e0-4b2a-8703", "Grm6f7QVJVuV@ufEOTgIC"} {
+		_, err := ParseUUIDFlexible(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %s, got %T: %v", input, err, err)
+		}
+	}
+}
+
+func TestExpandUUIDAuto(t *testing.T) {
+	testCases := []struct {
+		uuid      string
+		base62    string
+		base64url string
+	}{
+		{"53a8d1b9-4eca-4888-9b59-8fa91497857b", "2XrVqpuNYMfp5OSuawGnL1", "U6jRuU7KSIibWY-pFJeFew"},
+		{"8658bb57-992d-4a4d-9292-a5b118d28c8b", "45VWNy74cXYBydTM0JO3rv", "hli7V5ktSk2SkqWxGNKMiw"},
+		{"d26abc73-a6bf-49c6-984d-e08c941fad4a", "6P3AMn3h7r9JJSeHECCjJS", "0mq8c6a_ScaYTeCMlB-tSg"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.uuid, func(t *testing.T) {
+			expected := uuid.MustParse(tc.uuid)
+
+			for _, input := range []string{tc.base62, tc.base64url} {
+				expanded, err := ExpandUUIDAuto(input)
+				if err != nil {
+					t.Fatalf("Error expanding %s: %v", input, err)
+				}
+
+				if expanded != expected {
+					t.Errorf("Expected %s for %s, got %s", expected, input, expanded)
+				}
+			}
+		})
+	}
+
+	_, err := ExpandUUIDAuto("not a short id!")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError, got %T: %v", err, err)
+	}
+}
+
+func TestDecodeBoth(t *testing.T) {
+	longShort, err := Shorten("seventeen bytes!!")
 	if err != nil {
-		t.Fatalf("Error expanding short ID: %v", err)
+		t.Fatalf("Error shortening string: %v", err)
 	}
 
-	if expanded != testUUID {
-		t.Errorf("Expected %s, got %s", testUUID, expanded)
+	testCases := []struct {
+		name      string
+		shortID   string
+		uuidValid bool
+	}{
+		{"16-byte value", "2XrVqpuNYMfp5OSuawGnL1", true},
+		{"11-byte value", "AAwf93rvy4aWQVw", true},
+		{"17-byte value", longShort, false},
 	}
 
-	t.Logf("UUID: %s -> Short: %s -> UUID: %s", testUUID, short, expanded)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			asString, asUUID, uuidValid, err := DecodeBoth(tc.shortID)
+			if err != nil {
+				t.Fatalf("Error decoding %s: %v", tc.shortID, err)
+			}
+
+			expectedStr, err := Expand(tc.shortID)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", tc.shortID, err)
+			}
+
+			if asString != expectedStr {
+				t.Errorf("Expected string %q, got %q", expectedStr, asString)
+			}
+
+			if uuidValid != tc.uuidValid {
+				t.Fatalf("Expected uuidValid %t, got %t", tc.uuidValid, uuidValid)
+			}
+
+			expectedUUID, err := ExpandUUID(tc.shortID)
+			if uuidValid && (err != nil || asUUID != expectedUUID) {
+				t.Errorf("Expected UUID %s, got %s (ExpandUUID error: %v)", expectedUUID, asUUID, err)
+			}
+
+			if !uuidValid && (err == nil || asUUID != uuid.Nil) {
+				t.Errorf("Expected nil UUID and an ExpandUUID error, got %s and %v", asUUID, err)
+			}
+		})
+	}
+
+	_, _, _, err = DecodeBoth("@#$%")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError, got %T: %v", err, err)
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	testCases := map[string]string{
+		"00abc":                     "abc",
+		"abc":                       "abc",
+		"0":                         "0",
+		"000":                       "0",
+		"02XrVqpuNYMfp5OSuawGnL1":   "2XrVqpuNYMfp5OSuawGnL1",
+		"0000ssS9A1oUhTFAbdjd6w93P": "ssS9A1oUhTFAbdjd6w93P",
+	}
+
+	for input, expected := range testCases {
+		t.Run(input, func(t *testing.T) {
+			canonical, err := Canonicalize(input)
+			if err != nil {
+				t.Fatalf("Error canonicalizing %s: %v", input, err)
+			}
+
+			if canonical != expected {
+				t.Errorf("Expected %s, got %s", expected, canonical)
+			}
+		})
+	}
+
+	_, err := Canonicalize("00@bc")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError, got %T: %v", err, err)
+	}
 }
 
 func TestUUIDVersionPreservation(t *testing.T) {
@@ -269,6 +1211,7 @@ func TestErrorCases(t *testing.T) {
 	}{
 		{"empty_string", "", false},
 		{"invalid_short_@#$%", "@#$%", true},
+		{"empty_short_id", "", true},
 	}
 
 	for _, tc := range testCases {
@@ -305,6 +1248,13 @@ func TestError(t *testing.T) {
 			expectedInput:  "",
 			expectedReason: "input string cannot be empty",
 		},
+		{
+			name:           "empty short ID",
+			input:          "",
+			isShortID:      true,
+			expectedInput:  "",
+			expectedReason: "short ID cannot be empty",
+		},
 		{
 			name:           "invalid character in short ID",
 			input:          "@#$%",
@@ -370,6 +1320,122 @@ func TestError(t *testing.T) {
 	}
 }
 
+func TestDecodeErrorAnnotated(t *testing.T) {
+	testCases := []struct {
+		name             string
+		input            string
+		expectedPosition int
+		expected         string
+	}{
+		{
+			name:             "first character",
+			input:            "@bc",
+			expectedPosition: 1,
+			expected:         "@bc\n^\ninvalid character '@' in short ID (valid characters: 0-9, A-Z, a-z)",
+		},
+		{
+			name:             "middle character",
+			input:            "Grm6f7Q-JVuVrufEOTgIC",
+			expectedPosition: 8,
+			expected:         "Grm6f7Q-JVuVrufEOTgIC\n       ^\ninvalid character '-' in short ID (valid characters: 0-9, A-Z, a-z)",
+		},
+		{
+			name:             "multi-byte character",
+			input:            "abcé",
+			expectedPosition: 4,
+			expected:         "abcé\n   ^\ninvalid character 'é' in short ID (valid characters: 0-9, A-Z, a-z)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Expand(tc.input)
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+			}
+
+			if decodeErr.Position != tc.expectedPosition {
+				t.Errorf("Expected position %d, got %d", tc.expectedPosition, decodeErr.Position)
+			}
+
+			if annotated := decodeErr.Annotated(); annotated != tc.expected {
+				t.Errorf("Expected annotation:\n%s\ngot:\n%s", tc.expected, annotated)
+			}
+		})
+	}
+
+	// Errors not tied to a character have no caret line
+	_, err := Expand("")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+
+	if annotated := decodeErr.Annotated(); annotated != "\nshort ID cannot be empty" {
+		t.Errorf("Expected no caret line, got %q", annotated)
+	}
+}
+
+func TestDecodeErrorCodes(t *testing.T) {
+	strict, err := NewEncoder(DefaultAlphabet, WithStrictWidth(), WithRejectMaxUUID())
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	discard := func(_ any, err error) error { return err }
+	discardPair := func(_, _ any, err error) error { return err }
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected ErrorCode
+	}{
+		{"empty", discard(Expand("")), CodeEmpty},
+		{"invalid character", discard(Expand("ab@")), CodeInvalidChar},
+		{"truncated", discard(strict.ExpandUUID("2XrVqpuNYMfp5OSuawGnL")), CodeBadLength},
+		{"UUID overflow", discard(ExpandUUID("zzzzzzzzzzzzzzzzzzzzzz")), CodeOverflow},
+		{"64-bit overflow", discard(DecodeUint64("LygHa16AHYG")), CodeOverflow},
+		{"reserved", discard(strict.ExpandUUID(ShortMax)), CodeReserved},
+		{"checksum", discard(ExpandWithCRC("AAwf93rvy4aWQVw000000")), CodeChecksum},
+		{"invalid UUID", discard(ParseUUIDFlexible("not-a-uuid-at-all")), CodeInvalidUUID},
+		{"pair length", discardPair(ExpandUUIDPair("abc")), CodeBadLength},
+		{"pair half", discardPair(ExpandUUIDPair(strings.Repeat("z", 44))), CodeOverflow},
+		{"malformed", discard(ExpandGrouped("AAwf", "")), CodeMalformed},
+		{"unknown kind", func() error { _, _, err := DecodeSelfDescribing("z0"); return err }(), CodeInvalidChar},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var decodeErr *DecodeError
+			if !errors.As(tc.err, &decodeErr) {
+				t.Fatalf("Expected DecodeError, got %T: %v", tc.err, tc.err)
+			}
+
+			if decodeErr.Code != tc.expected {
+				t.Errorf("Expected code %s, got %s", tc.expected, decodeErr.Code)
+			}
+		})
+	}
+
+	if CodeInvalidChar.String() != "invalid_char" || ErrorCode(99).String() != "unknown" {
+		t.Errorf("Unexpected code names %s and %s", CodeInvalidChar, ErrorCode(99))
+	}
+}
+
+func TestExpandUUIDEmpty(t *testing.T) {
+	_, err := ExpandUUID("")
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("Expected DecodeError, got %T: %v", err, err)
+	}
+
+	if decodeErr.Reason != "short ID cannot be empty" {
+		t.Errorf("Expected reason %q, got %q", "short ID cannot be empty", decodeErr.Reason)
+	}
+}
+
 func TestErrorWrapping(t *testing.T) {
 	// Test that we can use errors.As with our error types
 	_, err := Shorten("")
@@ -428,6 +1494,7 @@ func BenchmarkExpand(b *testing.B) {
 
 func BenchmarkShortenUUID(b *testing.B) {
 	testUUID := uuid.New()
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -440,6 +1507,7 @@ func BenchmarkShortenUUID(b *testing.B) {
 
 func BenchmarkExpandUUID(b *testing.B) {
 	shortID := "2CvPdpytrcURpSLoPxYb30"
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -449,3 +1517,28 @@ func BenchmarkExpandUUID(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkShortenStringVsUUID(b *testing.B) {
+	// Encode the same UUID through both public paths so the gap stays visible
+	testUUID := uuid.New()
+
+	b.Run("Shorten", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := Shorten(testUUID.String())
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("ShortenUUID", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := ShortenUUID(testUUID)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,25 @@
+// Package shortuuidtest provides helpers for testing code that uses shortuuid.
+//
+// It imports the testing package and is meant to be used from _test.go files only.
+package shortuuidtest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/nhalm/shortuuid"
+)
+
+// allocRuns is the number of calls AllocsForShortenUUID averages over
+const allocRuns = 100
+
+// AllocsForShortenUUID reports the average number of heap allocations made by
+// a single shortuuid.ShortenUUID call for u, as measured by testing.AllocsPerRun.
+// Downstream projects can assert on it to guard against allocation regressions
+// on their hot paths. Like testing.AllocsPerRun, it must not be called from
+// parallel tests.
+func AllocsForShortenUUID(u uuid.UUID) float64 {
+	return testing.AllocsPerRun(allocRuns, func() {
+		_, _ = shortuuid.ShortenUUID(u)
+	})
+}
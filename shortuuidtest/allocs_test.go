@@ -0,0 +1,30 @@
+package shortuuidtest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// maxShortenUUIDAllocs is the allocation budget for a single ShortenUUID call.
+// The fixed 128-bit conversion allocates nothing beyond the result string.
+const maxShortenUUIDAllocs = 1
+
+func TestAllocsForShortenUUID(t *testing.T) {
+	testCases := []uuid.UUID{
+		uuid.Nil,
+		uuid.Max,
+		uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"),
+	}
+
+	for _, u := range testCases {
+		t.Run(u.String(), func(t *testing.T) {
+			allocs := AllocsForShortenUUID(u)
+			t.Logf("ShortenUUID(%s): %.1f allocations", u, allocs)
+
+			if allocs > maxShortenUUIDAllocs {
+				t.Errorf("Expected at most %d allocations, got %.1f", maxShortenUUIDAllocs, allocs)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package shortuuid
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ExpandUUIDWithSlug decodes an SEO-friendly ID such as
+// "Grm6f7QVJVuVrufEOTgIC-my-article-title". Everything up to the first dash is
+// the short UUID and is the only significant part; the rest is returned as the
+// slug, without the leading dash. An ID without a dash has an empty slug.
+// The short UUID part is always base62, whatever SetDefaultEncoder selected,
+// so that no alphabet glyph can be mistaken for the dash.
+// Returns an error if the short UUID part is invalid.
+func ExpandUUIDWithSlug(s string) (u uuid.UUID, slug string, err error) {
+	short, slug, _ := strings.Cut(s, "-")
+
+	u, err = defaultEncoder.ExpandUUID(short)
+	if err != nil {
+		return uuid.UUID{}, "", err
+	}
+
+	return u, slug, nil
+}
@@ -0,0 +1,49 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestExpandUUIDWithSlug(t *testing.T) {
+	expected := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+
+	testCases := []struct {
+		input string
+		slug  string
+	}{
+		{"Grm6f7QVJVuVrufEOTgIC-my-article-title", "my-article-title"},
+		{"Grm6f7QVJVuVrufEOTgIC-title", "title"},
+		{"Grm6f7QVJVuVrufEOTgIC", ""},
+		{"Grm6f7QVJVuVrufEOTgIC-", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			u, slug, err := ExpandUUIDWithSlug(tc.input)
+			if err != nil {
+				t.Fatalf("Error expanding %s: %v", tc.input, err)
+			}
+
+			if u != expected {
+				t.Errorf("Expected UUID %s, got %s", expected, u)
+			}
+
+			if slug != tc.slug {
+				t.Errorf("Expected slug %q, got %q", tc.slug, slug)
+			}
+		})
+	}
+}
+
+func TestExpandUUIDWithSlugInvalid(t *testing.T) {
+	for _, input := range []string{"", "-my-title", "Grm6f7QVJ_VuVrufEOTgIC-title"} {
+		_, _, err := ExpandUUIDWithSlug(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+}
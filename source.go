@@ -0,0 +1,78 @@
+package shortuuid
+
+import "github.com/google/uuid"
+
+// UUIDSource generates UUIDs. It decouples ID generation from a specific
+// generator so callers can inject deterministic sources in tests or choose a
+// UUID version.
+type UUIDSource interface {
+	NewUUID() (uuid.UUID, error)
+}
+
+// UUIDSourceFunc adapts an ordinary function, such as uuid.NewV7, to a UUIDSource.
+type UUIDSourceFunc func() (uuid.UUID, error)
+
+// NewUUID calls f.
+func (f UUIDSourceFunc) NewUUID() (uuid.UUID, error) {
+	return f()
+}
+
+// RandomSource returns the default UUID source, which generates version 4
+// UUIDs with uuid.NewRandom. It is a function rather than a variable so that
+// no package can replace the default for the whole process.
+func RandomSource() UUIDSource {
+	return UUIDSourceFunc(uuid.NewRandom)
+}
+
+// V7Source returns a UUID source that generates time-ordered version 7 UUIDs
+// with uuid.NewV7.
+func V7Source() UUIDSource {
+	return UUIDSourceFunc(uuid.NewV7)
+}
+
+// NewShort generates a UUID from src and returns its short ID like ShortenUUID.
+// A nil src uses RandomSource. Errors from the source are returned unchanged.
+func NewShort(src UUIDSource) (string, error) {
+	if src == nil {
+		src = RandomSource()
+	}
+
+	short, _, err := newFrom(src)
+	return short, err
+}
+
+// New generates a random version 4 UUID from RandomSource and returns its
+// short ID together with the UUID, like NewShort. Errors from the source are
+// returned unchanged.
+func New() (short string, id uuid.UUID, err error) {
+	return newFrom(RandomSource())
+}
+
+// NewV7 is like New but generates a time-ordered version 7 UUID from
+// V7Source. Errors from the source are returned unchanged.
+func NewV7() (short string, id uuid.UUID, err error) {
+	return newFrom(V7Source())
+}
+
+// newFrom generates a UUID from src and returns its short ID and the UUID
+func newFrom(src UUIDSource) (string, uuid.UUID, error) {
+	u, err := src.NewUUID()
+	if err != nil {
+		return "", uuid.UUID{}, err
+	}
+
+	short, err := activeEncoder().ShortenUUID(u)
+	if err != nil {
+		return "", uuid.UUID{}, err
+	}
+
+	return short, u, nil
+}
+
+// IsValid reports whether shortID expands to a UUID, applying exactly the
+// checks of ExpandUUID: it rejects empty input, characters outside the current
+// alphabet, and values above 128 bits (more than 32 hex digits).
+func IsValid(shortID string) bool {
+	_, err := ExpandUUID(shortID)
+	return err == nil
+}
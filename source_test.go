@@ -0,0 +1,167 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// fixedSource is a fake UUIDSource returning a known UUID
+type fixedSource struct {
+	u   uuid.UUID
+	err error
+}
+
+func (s fixedSource) NewUUID() (uuid.UUID, error) {
+	return s.u, s.err
+}
+
+func TestNewShort(t *testing.T) {
+	src := fixedSource{u: uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")}
+
+	short, err := NewShort(src)
+	if err != nil {
+		t.Fatalf("Error generating short ID: %v", err)
+	}
+
+	if short != "2XrVqpuNYMfp5OSuawGnL1" {
+		t.Errorf("Expected 2XrVqpuNYMfp5OSuawGnL1, got %s", short)
+	}
+
+	failing := fixedSource{err: errors.New("entropy exhausted")}
+	if _, err := NewShort(failing); err == nil || err.Error() != "entropy exhausted" {
+		t.Errorf("Expected source error, got %v", err)
+	}
+}
+
+func TestNewShortBuiltinSources(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     UUIDSource
+		version uuid.Version
+	}{
+		{"default", nil, 4},
+		{"random", RandomSource(), 4},
+		{"v7", V7Source(), 7},
+		{"func", UUIDSourceFunc(uuid.NewRandom), 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			short, err := NewShort(tc.src)
+			if err != nil {
+				t.Fatalf("Error generating short ID: %v", err)
+			}
+
+			u, err := ExpandUUID(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if u.Version() != tc.version {
+				t.Errorf("Expected version %d, got %d", tc.version, u.Version())
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name     string
+		generate func() (string, uuid.UUID, error)
+		version  uuid.Version
+	}{
+		{"v4", New, 4},
+		{"v7", NewV7, 7},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			short, id, err := tc.generate()
+			if err != nil {
+				t.Fatalf("Error generating short ID: %v", err)
+			}
+
+			if id.Version() != tc.version {
+				t.Errorf("Expected version %d, got %d", tc.version, id.Version())
+			}
+
+			expanded, err := ExpandUUID(short)
+			if err != nil {
+				t.Fatalf("Error expanding short ID %s: %v", short, err)
+			}
+
+			if expanded != id {
+				t.Errorf("Expected %s, got %s", id, expanded)
+			}
+		})
+	}
+
+	failing := fixedSource{err: errors.New("entropy exhausted")}
+	if _, _, err := newFrom(failing); err == nil || err.Error() != "entropy exhausted" {
+		t.Errorf("Expected source error, got %v", err)
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	testCases := map[string]bool{
+		"2XrVqpuNYMfp5OSuawGnL1":  true,
+		"ssS9A1oUhTFAbdjd6w93P":   true,
+		"0":                       true,
+		"7n42DGM5Tflk9n8mt7Fhc7":  true,
+		"7n42DGM5Tflk9n8mt7Fhc8":  false,
+		"2XrVqpuNYMfp5OSuawGnL12": false,
+		"invalid@chars":           false,
+		"":                        false,
+	}
+
+	for input, want := range testCases {
+		if got := IsValid(input); got != want {
+			t.Errorf("Expected IsValid(%q) to be %v, got %v", input, want, got)
+		}
+	}
+}
+
+func TestNewFollowsDefaultEncoder(t *testing.T) {
+	t.Cleanup(func() { SetDefaultEncoder(nil) })
+	SetDefaultEncoder(NoLookalikes)
+
+	u := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+	short, err := NewShort(fixedSource{u: u})
+	if err != nil {
+		t.Fatalf("Error generating short ID: %v", err)
+	}
+
+	want, err := NoLookalikes.ShortenUUID(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != want {
+		t.Errorf("Expected %s, got %s", want, short)
+	}
+
+	if !IsValid(short) {
+		t.Errorf("Expected %s to be valid under the swapped encoder", short)
+	}
+
+	// '0' is outside the NoLookalikes alphabet
+	if IsValid("2XrVqpuNYMfp5OSuawGnL0") {
+		t.Errorf("Expected base62-only characters to be rejected")
+	}
+
+	generated, id, err := New()
+	if err != nil {
+		t.Fatalf("Error generating short ID: %v", err)
+	}
+
+	expanded, err := ExpandUUID(generated)
+	if err != nil {
+		t.Fatalf("Error expanding generated short ID %s: %v", generated, err)
+	}
+
+	if expanded != id {
+		t.Errorf("Expected %s, got %s", id, expanded)
+	}
+}
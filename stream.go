@@ -0,0 +1,92 @@
+package shortuuid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// LineError associates an error with the line of input that caused it.
+type LineError struct {
+	Line int   // 1-based line number
+	Err  error // What went wrong with the line
+}
+
+func (e LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e LineError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeAll reads newline-delimited short UUIDs from r, expands each with
+// the base62 ExpandUUID and passes the result to fn, for ingesting short-ID logs.
+// Surrounding whitespace is trimmed and blank lines are skipped. Processing
+// stops at the first decode or callback error, which is returned as a
+// LineError carrying the line number; read errors are returned unchanged.
+func DecodeAll(r io.Reader, fn func(uuid.UUID) error) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		shortID := strings.TrimSpace(scanner.Text())
+		if shortID == "" {
+			continue
+		}
+
+		u, err := defaultEncoder.ExpandUUID(shortID)
+		if err != nil {
+			return LineError{Line: line, Err: err}
+		}
+
+		if err := fn(u); err != nil {
+			return LineError{Line: line, Err: err}
+		}
+	}
+
+	return scanner.Err()
+}
+
+// TranscodeAll reads newline-delimited short IDs from r, converts each from
+// one encoder's alphabet to another's with Transcode, and writes them to w one
+// per line, for migrating stored IDs in bulk. Surrounding whitespace is
+// trimmed and blank lines are written back as blank lines, so output lines
+// correspond to input lines. Processing stops at the first invalid ID, which
+// is returned as a LineError after the lines before it have been written;
+// read and write errors are returned unchanged.
+func TranscodeAll(r io.Reader, w io.Writer, from, to *Encoder) error {
+	scanner := bufio.NewScanner(r)
+	out := bufio.NewWriter(w)
+	line := 0
+	for scanner.Scan() {
+		line++
+		shortID := strings.TrimSpace(scanner.Text())
+
+		transcoded := ""
+		if shortID != "" {
+			var err error
+			transcoded, err = Transcode(shortID, from, to)
+			if err != nil {
+				if flushErr := out.Flush(); flushErr != nil {
+					return flushErr
+				}
+				return LineError{Line: line, Err: err}
+			}
+		}
+
+		if _, err := out.WriteString(transcoded + "\n"); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return out.Flush()
+}
@@ -0,0 +1,164 @@
+package shortuuid
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDecodeAll(t *testing.T) {
+	input := "2XrVqpuNYMfp5OSuawGnL1\n45VWNy74cXYBydTM0JO3rv\r\n\n  Grm6f7QVJVuVrufEOTgIC  \n"
+	expected := []uuid.UUID{
+		uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"),
+		uuid.MustParse("8658bb57-992d-4a4d-9292-a5b118d28c8b"),
+		uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628"),
+	}
+
+	var decoded []uuid.UUID
+	err := DecodeAll(strings.NewReader(input), func(u uuid.UUID) error {
+		decoded = append(decoded, u)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error decoding stream: %v", err)
+	}
+
+	if len(decoded) != len(expected) {
+		t.Fatalf("Expected %d UUIDs, got %d", len(expected), len(decoded))
+	}
+
+	for i := range expected {
+		if decoded[i] != expected[i] {
+			t.Errorf("Expected %s at %d, got %s", expected[i], i, decoded[i])
+		}
+	}
+}
+
+func TestDecodeAllMalformedLine(t *testing.T) {
+	input := "2XrVqpuNYMfp5OSuawGnL1\n\n45VW@y74cXYBydTM0JO3rv\nGrm6f7QVJVuVrufEOTgIC\n"
+
+	calls := 0
+	err := DecodeAll(strings.NewReader(input), func(u uuid.UUID) error {
+		calls++
+		return nil
+	})
+
+	var lineErr LineError
+	if !errors.As(err, &lineErr) {
+		t.Fatalf("Expected LineError, got %T: %v", err, err)
+	}
+
+	if lineErr.Line != 3 {
+		t.Errorf("Expected line 3, got %d", lineErr.Line)
+	}
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected wrapped DecodeError, got %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected processing to stop after 1 call, got %d", calls)
+	}
+}
+
+func TestDecodeAllCallbackError(t *testing.T) {
+	stop := errors.New("stop")
+	input := "2XrVqpuNYMfp5OSuawGnL1\n45VWNy74cXYBydTM0JO3rv\n"
+
+	err := DecodeAll(strings.NewReader(input), func(u uuid.UUID) error {
+		if u == uuid.MustParse("8658bb57-992d-4a4d-9292-a5b118d28c8b") {
+			return stop
+		}
+		return nil
+	})
+
+	if !errors.Is(err, stop) {
+		t.Fatalf("Expected callback error, got %v", err)
+	}
+
+	if expected := "line 2: stop"; err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestTranscodeAll(t *testing.T) {
+	base58, err := NewEncoder("123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz")
+	if err != nil {
+		t.Fatalf("Error creating encoder: %v", err)
+	}
+
+	uuids := []uuid.UUID{
+		uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"),
+		uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628"),
+		uuid.Nil,
+	}
+
+	var input, expected bytes.Buffer
+	for i, u := range uuids {
+		if i == 1 {
+			input.WriteString("\n")
+			expected.WriteString("\n")
+		}
+
+		short, err := base58.ShortenUUID(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID: %v", err)
+		}
+		input.WriteString(short + "\n")
+
+		short, err = ShortenUUID(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID: %v", err)
+		}
+		expected.WriteString(short + "\n")
+	}
+
+	var output bytes.Buffer
+	if err := TranscodeAll(&input, &output, base58, defaultEncoder); err != nil {
+		t.Fatalf("Error transcoding: %v", err)
+	}
+
+	if output.String() != expected.String() {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected.String(), output.String())
+	}
+
+	// And back again
+	var roundTrip bytes.Buffer
+	if err := TranscodeAll(&output, &roundTrip, defaultEncoder, base58); err != nil {
+		t.Fatalf("Error transcoding: %v", err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(roundTrip.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		if _, err := base58.ExpandUUID(line); err != nil {
+			t.Errorf("Error expanding transcoded short ID %s: %v", line, err)
+		}
+	}
+}
+
+func TestTranscodeAllInvalidLine(t *testing.T) {
+	// '0' and '1' are not part of the NoLookalikes alphabet
+	input := "6xdhxu3GbySJPRYUk43FBn\n10\nzz\n"
+
+	var output bytes.Buffer
+	err := TranscodeAll(strings.NewReader(input), &output, NoLookalikes, defaultEncoder)
+
+	var lineErr LineError
+	if !errors.As(err, &lineErr) {
+		t.Fatalf("Expected LineError, got %T: %v", err, err)
+	}
+
+	if lineErr.Line != 2 {
+		t.Errorf("Expected line 2, got %d", lineErr.Line)
+	}
+
+	if strings.Count(output.String(), "\n") != 1 {
+		t.Errorf("Expected the line before the error to be written, got %q", output.String())
+	}
+}
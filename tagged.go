@@ -0,0 +1,57 @@
+package shortuuid
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ShortenUUIDTagged converts a UUID to a short ID prefixed with a single
+// character encoding tag, such as an entity type. The tag must be below 62 so
+// that it fits in one base62 character.
+// Returns an error if the tag is out of range.
+func ShortenUUIDTagged(u uuid.UUID, tag byte) (string, error) {
+	if int(tag) >= len(defaultBase62) {
+		return "", &EncodeError{
+			Input:  u.String(),
+			Reason: fmt.Sprintf("tag %d out of range: must be below %d", tag, len(defaultBase62)),
+		}
+	}
+
+	short, err := defaultEncoder.ShortenUUID(u)
+	if err != nil {
+		return "", err
+	}
+
+	return string(defaultBase62[tag]) + short, nil
+}
+
+// ExpandUUIDTagged converts a short ID created by ShortenUUIDTagged back to
+// its UUID and tag. The first character is the tag, the rest the short UUID.
+// Returns an error if the input is too short or either part is invalid.
+func ExpandUUIDTagged(s string) (uuid.UUID, byte, error) {
+	runes := []rune(s)
+	if len(runes) < 2 {
+		return uuid.UUID{}, 0, &DecodeError{
+			ShortID: s,
+			Reason:  "tagged short ID must contain a tag and a short UUID",
+			Code:    CodeMalformed,
+		}
+	}
+
+	tag := runeIndex(defaultBase62, runes[0])
+	if tag == -1 {
+		return uuid.UUID{}, 0, &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("invalid tag character '%c' (valid characters: 0-9, A-Z, a-z)", runes[0]),
+			Code:    CodeInvalidChar,
+		}
+	}
+
+	u, err := defaultEncoder.ExpandUUID(string(runes[1:]))
+	if err != nil {
+		return uuid.UUID{}, 0, err
+	}
+
+	return u, byte(tag), nil
+}
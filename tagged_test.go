@@ -0,0 +1,59 @@
+package shortuuid
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenUUIDTagged(t *testing.T) {
+	testUUID := uuid.MustParse("1d152c86-c436-4d47-9269-006c7469b867")
+
+	for _, tag := range []byte{0, 1, 9, 10, 35, 36, 61} {
+		t.Run(fmt.Sprintf("tag_%d", tag), func(t *testing.T) {
+			short, err := ShortenUUIDTagged(testUUID, tag)
+			if err != nil {
+				t.Fatalf("Error shortening UUID with tag %d: %v", tag, err)
+			}
+
+			if short[1:] != "ssS9A1oUhTFAbdjd6w93P" {
+				t.Errorf("Expected short UUID after the tag, got %s", short)
+			}
+
+			expanded, gotTag, err := ExpandUUIDTagged(short)
+			if err != nil {
+				t.Fatalf("Error expanding tagged short ID %s: %v", short, err)
+			}
+
+			if expanded != testUUID {
+				t.Errorf("Expected UUID %s, got %s", testUUID, expanded)
+			}
+
+			if gotTag != tag {
+				t.Errorf("Expected tag %d, got %d", tag, gotTag)
+			}
+
+			t.Logf("tag %d: %s", tag, short)
+		})
+	}
+}
+
+func TestShortenUUIDTaggedErrors(t *testing.T) {
+	for _, tag := range []byte{62, 100, 255} {
+		_, err := ShortenUUIDTagged(uuid.New(), tag)
+		var encodeErr *EncodeError
+		if !errors.As(err, &encodeErr) {
+			t.Errorf("Expected EncodeError for tag %d, got %T: %v", tag, err, err)
+		}
+	}
+
+	for _, input := range []string{"", "A", "@2XrVqpuNYMfp5OSuawGnL1", "A2XrVqpu@YMfp5OSuawGnL1"} {
+		_, _, err := ExpandUUIDTagged(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package shortuuid
+
+import "github.com/google/uuid"
+
+// UnknownShort is the well-known short ID for "no value" or "unknown", for APIs
+// that need an absent-ID placeholder; it stands for the nil UUID, uuid.Nil. It
+// is 22 'z' characters, a value above 128 bits in base62, so the base62
+// encoding of a real UUID never collides with it. The OrUnknown helpers always use base62, whatever SetDefaultEncoder
+// selected. Base62 ExpandUUID rejects it with ErrUUIDOverflow; use
+// ExpandUUIDOrUnknown to accept it.
+const UnknownShort = "zzzzzzzzzzzzzzzzzzzzzz"
+
+// IsUnknown reports whether shortID is exactly UnknownShort.
+func IsUnknown(shortID string) bool {
+	return shortID == UnknownShort
+}
+
+// ShortenUUIDOrUnknown converts a UUID to its base62 short ID, except that
+// uuid.Nil encodes to UnknownShort.
+func ShortenUUIDOrUnknown(u uuid.UUID) (string, error) {
+	if u == uuid.Nil {
+		return UnknownShort, nil
+	}
+
+	return defaultEncoder.ShortenUUID(u)
+}
+
+// ExpandUUIDOrUnknown converts a base62 short ID back to a UUID, except that
+// UnknownShort decodes to uuid.Nil.
+// Returns an error if any other short ID is invalid.
+func ExpandUUIDOrUnknown(shortID string) (uuid.UUID, error) {
+	if IsUnknown(shortID) {
+		return uuid.Nil, nil
+	}
+
+	return defaultEncoder.ExpandUUID(shortID)
+}
@@ -0,0 +1,85 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestUnknownShort(t *testing.T) {
+	short, err := ShortenUUIDOrUnknown(uuid.Nil)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != UnknownShort || !IsUnknown(short) {
+		t.Errorf("Expected %s, got %s", UnknownShort, short)
+	}
+
+	expanded, err := ExpandUUIDOrUnknown(short)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", short, err)
+	}
+
+	if expanded != uuid.Nil {
+		t.Errorf("Expected %s, got %s", uuid.Nil, expanded)
+	}
+
+	// The sentinel is not a valid short ID for any real UUID
+	if _, err := ExpandUUID(UnknownShort); !errors.Is(err, ErrUUIDOverflow) {
+		t.Errorf("Expected ErrUUIDOverflow, got %v", err)
+	}
+}
+
+func TestUnknownShortOtherUUIDs(t *testing.T) {
+	for _, u := range []uuid.UUID{uuid.Max, uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")} {
+		short, err := ShortenUUIDOrUnknown(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID: %v", err)
+		}
+
+		if IsUnknown(short) {
+			t.Errorf("Expected %s not to be the unknown sentinel", u)
+		}
+
+		expanded, err := ExpandUUIDOrUnknown(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != u {
+			t.Errorf("Expected %s, got %s", u, expanded)
+		}
+	}
+
+	for _, input := range []string{"zzzzzzzzzzzzzzzzzzzzz", "Zzzzzzzzzzzzzzzzzzzzzz", ""} {
+		if IsUnknown(input) {
+			t.Errorf("Expected %q not to be the unknown sentinel", input)
+		}
+	}
+}
+
+func TestUnknownShortIgnoresDefaultEncoder(t *testing.T) {
+	t.Cleanup(func() { SetDefaultEncoder(nil) })
+	SetDefaultEncoder(NoLookalikes)
+
+	u := uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b")
+	short, err := ShortenUUIDOrUnknown(u)
+	if err != nil {
+		t.Fatalf("Error shortening UUID: %v", err)
+	}
+
+	if short != "2XrVqpuNYMfp5OSuawGnL1" {
+		t.Errorf("Expected 2XrVqpuNYMfp5OSuawGnL1, got %s", short)
+	}
+
+	expanded, err := ExpandUUIDOrUnknown(short)
+	if err != nil {
+		t.Fatalf("Error expanding short ID %s: %v", short, err)
+	}
+
+	if expanded != u {
+		t.Errorf("Expected %s, got %s", u, expanded)
+	}
+}
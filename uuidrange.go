@@ -0,0 +1,130 @@
+package shortuuid
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/google/uuid"
+)
+
+// maxRangeOffsets is the largest bitmap ShortenUUIDRange accepts
+const maxRangeOffsets = 4096
+
+// ShortenUUIDRange encodes a set of UUIDs near base compactly, as base plus a
+// bitmap where present[i] marks base+i as a member. The token is the
+// ShortenUUID encoding of base, left-padded with '0' to 22 characters, followed
+// by the bitmap as a base62 integer with a leading marker bit so trailing
+// false entries are kept. Each offset costs about 0.17 characters, so a
+// 64-offset bitmap adds 11 characters.
+//
+// The bitmap is limited to 4096 offsets (at most 689 characters). Returns an
+// *EncodeError if present is longer, or if a marked offset would exceed the
+// max UUID.
+func ShortenUUIDRange(base uuid.UUID, present []bool) (string, error) {
+	if len(present) > maxRangeOffsets {
+		return "", &EncodeError{
+			Input:  base.String(),
+			Reason: fmt.Sprintf("range bitmap has %d offsets, above the limit of %d", len(present), maxRangeOffsets),
+		}
+	}
+
+	bitmap := new(big.Int).SetBit(new(big.Int), len(present), 1)
+	last := -1
+	for i, ok := range present {
+		if ok {
+			bitmap.SetBit(bitmap, i, 1)
+			last = i
+		}
+	}
+
+	if last >= 0 {
+		if _, ok := rangeMember(base, last); !ok {
+			return "", &EncodeError{
+				Input:  base.String(),
+				Reason: fmt.Sprintf("offset %d exceeds the max UUID", last),
+			}
+		}
+	}
+
+	short, err := defaultEncoder.ShortenUUID(base)
+	if err != nil {
+		return "", err
+	}
+
+	return defaultEncoder.padLeft(short, uuidWidth) + defaultEncoder.intToBase(bitmap), nil
+}
+
+// ExpandUUIDRange converts a token created by ShortenUUIDRange back to the
+// member UUIDs in ascending order.
+// Returns a *DecodeError if the token is too short, the bitmap exceeds 4096
+// offsets, a member would exceed the max UUID, or either part is invalid.
+func ExpandUUIDRange(s string) ([]uuid.UUID, error) {
+	if len(s) <= uuidWidth {
+		return nil, &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("range token must be longer than %d characters, got %d", uuidWidth, len(s)),
+			Err:     ErrTruncated,
+			Code:    CodeBadLength,
+		}
+	}
+
+	base, err := defaultEncoder.ExpandUUID(s[:uuidWidth])
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap, err := DecodeInt(s[uuidWidth:])
+	if err != nil {
+		return nil, err
+	}
+
+	n := bitmap.BitLen() - 1
+	if n < 0 {
+		return nil, &DecodeError{
+			ShortID: s,
+			Reason:  "range bitmap is missing its marker bit",
+			Code:    CodeMalformed,
+		}
+	}
+
+	if n > maxRangeOffsets {
+		return nil, &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("range bitmap has %d offsets, above the limit of %d", n, maxRangeOffsets),
+			Code:    CodeOverflow,
+		}
+	}
+
+	var members []uuid.UUID
+	for i := 0; i < n; i++ {
+		if bitmap.Bit(i) == 0 {
+			continue
+		}
+
+		u, ok := rangeMember(base, i)
+		if !ok {
+			return nil, &DecodeError{
+				ShortID: s,
+				Reason:  fmt.Sprintf("offset %d exceeds the max UUID", i),
+				Err:     ErrUUIDOverflow,
+				Code:    CodeOverflow,
+			}
+		}
+		members = append(members, u)
+	}
+
+	return members, nil
+}
+
+// rangeMember returns base+offset, or false if it exceeds 128 bits
+func rangeMember(base uuid.UUID, offset int) (uuid.UUID, bool) {
+	num := new(big.Int).SetBytes(base[:])
+	num.Add(num, big.NewInt(int64(offset)))
+	if num.BitLen() > 128 {
+		return uuid.UUID{}, false
+	}
+
+	var u uuid.UUID
+	num.FillBytes(u[:])
+	return u, true
+}
@@ -0,0 +1,112 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortenUUIDRange(t *testing.T) {
+	base := uuid.MustParse("018f3a2b-0000-7000-8000-000000000100")
+
+	sparse := make([]bool, 1000)
+	sparse[0], sparse[17], sparse[999] = true, true, true
+
+	dense := make([]bool, 64)
+	for i := range dense {
+		dense[i] = i != 5
+	}
+
+	testCases := map[string][]bool{
+		"empty":  nil,
+		"sparse": sparse,
+		"dense":  dense,
+		"none":   make([]bool, 10),
+	}
+
+	for name, present := range testCases {
+		t.Run(name, func(t *testing.T) {
+			token, err := ShortenUUIDRange(base, present)
+			if err != nil {
+				t.Fatalf("Error shortening range: %v", err)
+			}
+
+			members, err := ExpandUUIDRange(token)
+			if err != nil {
+				t.Fatalf("Error expanding range %s: %v", token, err)
+			}
+
+			var want []uuid.UUID
+			for i, ok := range present {
+				if ok {
+					u, _ := rangeMember(base, i)
+					want = append(want, u)
+				}
+			}
+
+			if len(members) != len(want) {
+				t.Fatalf("Expected %d members, got %d", len(want), len(members))
+			}
+
+			for i := range want {
+				if members[i] != want[i] {
+					t.Errorf("Expected member %d to be %s, got %s", i, want[i], members[i])
+				}
+			}
+
+			t.Logf("%d offsets -> %d characters", len(present), len(token))
+		})
+	}
+}
+
+func TestShortenUUIDRangeDenseLength(t *testing.T) {
+	dense := make([]bool, 64)
+	for i := range dense {
+		dense[i] = true
+	}
+
+	token, err := ShortenUUIDRange(uuid.Nil, dense)
+	if err != nil {
+		t.Fatalf("Error shortening range: %v", err)
+	}
+
+	if len(token) != 22+11 {
+		t.Errorf("Expected 33 characters, got %d: %s", len(token), token)
+	}
+}
+
+func TestShortenUUIDRangeLimits(t *testing.T) {
+	if _, err := ShortenUUIDRange(uuid.Nil, make([]bool, 4097)); err == nil {
+		t.Errorf("Expected error for bitmap above the limit")
+	}
+
+	var encodeErr *EncodeError
+	_, err := ShortenUUIDRange(uuid.Max, []bool{true, true})
+	if !errors.As(err, &encodeErr) {
+		t.Errorf("Expected EncodeError for offset past the max UUID, got %v", err)
+	}
+
+	if _, err := ShortenUUIDRange(uuid.Max, []bool{true, false}); err != nil {
+		t.Errorf("Expected unmarked offsets past the max UUID to be allowed, got %v", err)
+	}
+}
+
+func TestExpandUUIDRangeInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"truncated": "Grm6f7QVJVuVrufEOTgIC",
+		"no marker": "0Grm6f7QVJVuVrufEOTgIC0",
+		"overflow":  "7n42DGM5Tflk9n8mt7Fhc77",
+	}
+
+	for name, input := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ExpandUUIDRange(input)
+
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Errorf("Expected DecodeError for %s, got %T: %v", input, err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package shortuuid
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// v1SortableOrder lists, for each position of the reordered bytes, the index
+// of the version 1 UUID byte it takes: time_hi_and_version, time_mid and
+// time_low, followed by clock_seq and node unchanged
+var v1SortableOrder = [16]int{6, 7, 4, 5, 0, 1, 2, 3, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// ShortenUUIDv1Sortable converts a version 1 UUID to a short ID that sorts
+// chronologically. Version 1 UUIDs store the low bits of their timestamp
+// first, so their plain short IDs do not sort by time; this reorders the
+// timestamp fields from most to least significant (as version 6 does) before
+// encoding, and left-pads the result with '0' to 22 characters so that lexical
+// order matches. It applies only to version 1 UUIDs; other versions already
+// sort as well as they can, or not at all.
+// Returns an *EncodeError if u is not a version 1 UUID.
+func ShortenUUIDv1Sortable(u uuid.UUID) (string, error) {
+	if u.Version() != 1 {
+		return "", &EncodeError{
+			Input:  u.String(),
+			Reason: fmt.Sprintf("UUID version %d is not 1", u.Version()),
+		}
+	}
+
+	var reordered [16]byte
+	for i, from := range v1SortableOrder {
+		reordered[i] = u[from]
+	}
+
+	return defaultEncoder.padLeft(defaultEncoder.encodeBytes(reordered[:]), uuidWidth), nil
+}
+
+// ExpandUUIDv1Sortable converts a short ID created by ShortenUUIDv1Sortable
+// back to the version 1 UUID.
+// Returns a *DecodeError if the short ID is invalid or does not decode to a
+// version 1 UUID.
+func ExpandUUIDv1Sortable(shortID string) (uuid.UUID, error) {
+	var reordered [16]byte
+	if err := defaultEncoder.decode128(shortID, &reordered); err != nil {
+		return uuid.UUID{}, err
+	}
+
+	var u uuid.UUID
+	for i, from := range v1SortableOrder {
+		u[from] = reordered[i]
+	}
+
+	if u.Version() != 1 {
+		return uuid.UUID{}, &DecodeError{
+			ShortID: shortID,
+			Reason:  fmt.Sprintf("decoded to UUID version %d, expected 1", u.Version()),
+			Code:    CodeInvalidUUID,
+		}
+	}
+
+	return u, nil
+}
@@ -0,0 +1,106 @@
+package shortuuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// newV1 builds a version 1 UUID with the given 60-bit timestamp
+func newV1(timestamp uint64) uuid.UUID {
+	var u uuid.UUID
+	binary.BigEndian.PutUint32(u[0:], uint32(timestamp))
+	binary.BigEndian.PutUint16(u[4:], uint16(timestamp>>32))
+	binary.BigEndian.PutUint16(u[6:], uint16(timestamp>>48)&0x0fff|0x1000)
+	copy(u[8:], []byte{0x80, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07})
+	return u
+}
+
+func TestShortenUUIDv1Sortable(t *testing.T) {
+	// Timestamps chosen so that time_low wraps and time_mid and time_hi change
+	timestamps := []uint64{
+		0x01d2_3456_ffff_fff0,
+		0x01d2_3457_0000_0001,
+		0x01d2_3457_8000_0000,
+		0x01d3_0000_0000_0000,
+		0x0fff_ffff_ffff_ffff,
+	}
+
+	var shorts []string
+	for _, ts := range timestamps {
+		u := newV1(ts)
+		if u.Version() != 1 {
+			t.Fatalf("Expected version 1, got %d", u.Version())
+		}
+
+		short, err := ShortenUUIDv1Sortable(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", u, err)
+		}
+
+		if len(short) != 22 {
+			t.Errorf("Expected 22 characters, got %d in %s", len(short), short)
+		}
+
+		expanded, err := ExpandUUIDv1Sortable(short)
+		if err != nil {
+			t.Fatalf("Error expanding short ID %s: %v", short, err)
+		}
+
+		if expanded != u {
+			t.Errorf("Expected %s, got %s", u, expanded)
+		}
+
+		shorts = append(shorts, short)
+	}
+
+	if !sort.StringsAreSorted(shorts) {
+		t.Errorf("Expected short IDs in chronological order, got %v", shorts)
+	}
+
+	// Without reordering the wrap of time_low breaks the order
+	before, _ := ShortenUUID(newV1(timestamps[0]))
+	after, _ := ShortenUUID(newV1(timestamps[1]))
+	if before < after {
+		t.Errorf("Expected plain short IDs to be out of order, got %s < %s", before, after)
+	}
+}
+
+func TestShortenUUIDv1SortableGenerated(t *testing.T) {
+	var shorts []string
+	for i := 0; i < 50; i++ {
+		u, err := uuid.NewUUID()
+		if err != nil {
+			t.Fatalf("Error generating UUID: %v", err)
+		}
+
+		short, err := ShortenUUIDv1Sortable(u)
+		if err != nil {
+			t.Fatalf("Error shortening UUID %s: %v", u, err)
+		}
+		shorts = append(shorts, short)
+	}
+
+	if !sort.StringsAreSorted(shorts) {
+		t.Errorf("Expected generated short IDs in chronological order, got %v", shorts)
+	}
+}
+
+func TestShortenUUIDv1SortableInvalid(t *testing.T) {
+	_, err := ShortenUUIDv1Sortable(uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"))
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Errorf("Expected EncodeError for version 4 UUID, got %T: %v", err, err)
+	}
+
+	for _, shortID := range []string{"", "2XrVqpuNYMfp5OSuawGnL1", "zzzzzzzzzzzzzzzzzzzzzz"} {
+		_, err := ExpandUUIDv1Sortable(shortID)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q, got %T: %v", shortID, err, err)
+		}
+	}
+}
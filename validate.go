@@ -0,0 +1,50 @@
+package shortuuid
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// IndexedError associates an error with the position of the offending entry
+// in a batch.
+type IndexedError struct {
+	Index int   // Position of the entry in the input slice
+	Err   error // What went wrong with the entry
+}
+
+func (e IndexedError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e IndexedError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateWidth checks that every short ID in ids is exactly width characters
+// long, catching truncated or concatenated entries in bulk before decoding.
+// It returns one IndexedError per offending entry, wrapping a *DecodeError;
+// entries that are too short also wrap ErrTruncated. The result is nil if all
+// entries have the expected width.
+func ValidateWidth(ids []string, width int) []IndexedError {
+	var errs []IndexedError
+	for i, id := range ids {
+		n := utf8.RuneCountInString(id)
+		if n == width {
+			continue
+		}
+
+		decodeErr := &DecodeError{
+			ShortID: id,
+			Reason:  fmt.Sprintf("expected width %d, got %d", width, n),
+			Code:    CodeBadLength,
+		}
+		if n < width {
+			decodeErr.Err = ErrTruncated
+		}
+
+		errs = append(errs, IndexedError{Index: i, Err: decodeErr})
+	}
+
+	return errs
+}
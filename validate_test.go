@@ -0,0 +1,50 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateWidth(t *testing.T) {
+	ids := []string{
+		"2XrVqpuNYMfp5OSuawGnL1",                       // correct
+		"0ssS9A1oUhTFAbdjd6w93P",                       // correct, padded
+		"2XrVqpuNYMfp5OSuawGnL",                        // short
+		"2XrVqpuNYMfp5OSuawGnL12XrVqpuNYMfp5OSuawGnL1", // concatenated
+		"", // empty
+	}
+
+	errs := ValidateWidth(ids, 22)
+	if len(errs) != 3 {
+		t.Fatalf("Expected 3 errors, got %d: %v", len(errs), errs)
+	}
+
+	expected := []struct {
+		index     int
+		truncated bool
+	}{
+		{2, true},
+		{3, false},
+		{4, true},
+	}
+
+	for i, want := range expected {
+		got := errs[i]
+		if got.Index != want.index {
+			t.Errorf("Expected error %d at index %d, got %d", i, want.index, got.Index)
+		}
+
+		var decodeErr *DecodeError
+		if !errors.As(got, &decodeErr) {
+			t.Errorf("Expected DecodeError at index %d, got %T", got.Index, got.Err)
+		}
+
+		if errors.Is(got, ErrTruncated) != want.truncated {
+			t.Errorf("Expected truncated=%t at index %d, got %v", want.truncated, got.Index, got)
+		}
+	}
+
+	if errs := ValidateWidth(ids[:2], 22); errs != nil {
+		t.Errorf("Expected no errors for correct-width entries, got %v", errs)
+	}
+}
@@ -0,0 +1,107 @@
+package shortuuid
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ShortUUID is a UUID that serializes as its base62 short ID, for struct
+// fields that cross JSON, text and database/sql boundaries. Convert with
+// ShortUUID(u) and back with UUID. The zero value is the nil UUID and
+// serializes as "0" rather than an empty string, so it stays unambiguous.
+// Serialization always uses the base62 alphabet, regardless of
+// SetDefaultEncoder, so stored values remain readable.
+type ShortUUID uuid.UUID
+
+// UUID returns the underlying UUID.
+func (s ShortUUID) UUID() uuid.UUID {
+	return uuid.UUID(s)
+}
+
+// String returns the short ID form.
+func (s ShortUUID) String() string {
+	// ShortenUUID cannot fail for the default encoder
+	short, _ := defaultEncoder.ShortenUUID(uuid.UUID(s))
+	return short
+}
+
+// MarshalText implements encoding.TextMarshaler, emitting the short ID.
+func (s ShortUUID) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing a short ID.
+// Returns a *DecodeError if text is not a valid short UUID.
+func (s *ShortUUID) UnmarshalText(text []byte) error {
+	u, err := defaultEncoder.ExpandUUID(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = ShortUUID(u)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, emitting the short ID as a JSON string.
+func (s ShortUUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, parsing a JSON string holding a
+// short ID. A JSON null leaves s unchanged, following encoding/json convention.
+// Returns a *DecodeError if data is not a string or not a valid short UUID.
+func (s *ShortUUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var short string
+	if err := json.Unmarshal(data, &short); err != nil {
+		return &DecodeError{
+			ShortID: string(data),
+			Reason:  "short UUID must be a JSON string",
+			Code:    CodeMalformed,
+		}
+	}
+
+	return s.UnmarshalText([]byte(short))
+}
+
+// Value implements driver.Valuer, storing the short ID as a string.
+func (s ShortUUID) Value() (driver.Value, error) {
+	return s.String(), nil
+}
+
+// Scan implements sql.Scanner. It accepts a short ID as a string or []byte,
+// or the 16 raw bytes of a UUID as stored in binary columns. A []byte of
+// exactly 16 bytes is first parsed as a short ID, since 16-character short IDs
+// exist, and read as raw UUID bytes only if that fails. Scan nullable columns
+// into a *ShortUUID field, which database/sql sets to nil for NULL.
+// Returns a *DecodeError for NULL, other types, or a malformed short ID.
+func (s *ShortUUID) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return s.UnmarshalText([]byte(src))
+	case []byte:
+		err := s.UnmarshalText(src)
+		if err != nil && len(src) == 16 {
+			*s = ShortUUID(uuid.UUID(src))
+			return nil
+		}
+		return err
+	case nil:
+		return &DecodeError{
+			Reason: "cannot scan NULL into ShortUUID",
+			Code:   CodeEmpty,
+		}
+	default:
+		return &DecodeError{
+			ShortID: fmt.Sprint(src),
+			Reason:  fmt.Sprintf("cannot scan %T into ShortUUID", src),
+			Code:    CodeMalformed,
+		}
+	}
+}
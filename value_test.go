@@ -0,0 +1,134 @@
+package shortuuid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestShortUUIDJSON(t *testing.T) {
+	type record struct {
+		ID    ShortUUID  `json:"id"`
+		Owner *ShortUUID `json:"owner"`
+	}
+
+	u := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+	owner := ShortUUID(uuid.Max)
+
+	data, err := json.Marshal(record{ID: ShortUUID(u), Owner: &owner})
+	if err != nil {
+		t.Fatalf("Error marshaling JSON: %v", err)
+	}
+
+	want := `{"id":"Grm6f7QVJVuVrufEOTgIC","owner":"7n42DGM5Tflk9n8mt7Fhc7"}`
+	if string(data) != want {
+		t.Errorf("Expected %s, got %s", want, data)
+	}
+
+	var decoded record
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Error unmarshaling JSON: %v", err)
+	}
+
+	if decoded.ID.UUID() != u || decoded.Owner == nil || *decoded.Owner != owner {
+		t.Errorf("Expected %s and %s, got %+v", u, owner, decoded)
+	}
+
+	// The zero value is the encoded nil UUID, not an empty string
+	data, err = json.Marshal(record{})
+	if err != nil {
+		t.Fatalf("Error marshaling JSON: %v", err)
+	}
+
+	if want := `{"id":"0","owner":null}`; string(data) != want {
+		t.Errorf("Expected %s, got %s", want, data)
+	}
+}
+
+func TestShortUUIDUnmarshalInvalid(t *testing.T) {
+	for _, input := range []string{`"invalid@chars"`, `42`, `""`, `"zzzzzzzzzzzzzzzzzzzzzz"`} {
+		var s ShortUUID
+		err := json.Unmarshal([]byte(input), &s)
+
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %s, got %T: %v", input, err, err)
+		}
+	}
+}
+
+func TestShortUUIDText(t *testing.T) {
+	u := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+
+	text, err := ShortUUID(u).MarshalText()
+	if err != nil {
+		t.Fatalf("Error marshaling text: %v", err)
+	}
+
+	if string(text) != "Grm6f7QVJVuVrufEOTgIC" || ShortUUID(u).String() != "Grm6f7QVJVuVrufEOTgIC" {
+		t.Errorf("Expected Grm6f7QVJVuVrufEOTgIC, got %s", text)
+	}
+
+	var s ShortUUID
+	if err := s.UnmarshalText(text); err != nil {
+		t.Fatalf("Error unmarshaling text: %v", err)
+	}
+
+	if s.UUID() != u {
+		t.Errorf("Expected %s, got %s", u, s.UUID())
+	}
+}
+
+func TestShortUUIDSQL(t *testing.T) {
+	u := uuid.MustParse("08f057f3-23e0-4b2a-8703-03f2dab8f628")
+
+	value, err := ShortUUID(u).Value()
+	if err != nil {
+		t.Fatalf("Error getting value: %v", err)
+	}
+
+	if value != "Grm6f7QVJVuVrufEOTgIC" {
+		t.Errorf("Expected Grm6f7QVJVuVrufEOTgIC, got %v", value)
+	}
+
+	testCases := map[string]any{
+		"string":    "Grm6f7QVJVuVrufEOTgIC",
+		"bytes":     []byte("Grm6f7QVJVuVrufEOTgIC"),
+		"raw bytes": u[:],
+	}
+
+	for name, src := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var s ShortUUID
+			if err := s.Scan(src); err != nil {
+				t.Fatalf("Error scanning %v: %v", src, err)
+			}
+
+			if s.UUID() != u {
+				t.Errorf("Expected %s, got %s", u, s.UUID())
+			}
+		})
+	}
+
+	// A 16-character short ID is also 16 bytes long and must not be read raw
+	var short ShortUUID
+	if err := short.Scan([]byte("CsYXGgXQRURtExA9")); err != nil {
+		t.Fatalf("Error scanning 16-character short ID: %v", err)
+	}
+
+	if want := uuid.MustParse("00000000-2000-0000-0000-000000000001"); short.UUID() != want {
+		t.Errorf("Expected %s, got %s", want, short.UUID())
+	}
+
+	for _, src := range []any{nil, 42, "invalid@chars", []byte("too-short")} {
+		var s ShortUUID
+		err := s.Scan(src)
+
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %v, got %T: %v", src, err, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package shortuuid
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatVersioned combines a scheme version and a short ID into a public ID
+// such as "v1-Grm6f7QVJVuVrufEOTgIC". The version should be non-negative;
+// ParseVersioned rejects negative versions.
+func FormatVersioned(version int, short string) string {
+	return "v" + strconv.Itoa(version) + "-" + short
+}
+
+// ParseVersioned splits a versioned ID created by FormatVersioned into its
+// version and short ID. The input is split on the first dash; the leading
+// token must be 'v' followed by decimal digits, and the short ID must not be
+// empty. The short ID is returned as-is for the caller to decode.
+func ParseVersioned(s string) (version int, short string, err error) {
+	token, short, found := strings.Cut(s, "-")
+	if !found {
+		return 0, "", &DecodeError{
+			ShortID: s,
+			Reason:  "versioned ID must contain a version and a short ID separated by '-'",
+			Code:    CodeMalformed,
+		}
+	}
+
+	digits, ok := strings.CutPrefix(token, "v")
+	if !ok || digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return 0, "", &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("invalid version token '%s': expected 'v' followed by digits", token),
+			Code:    CodeMalformed,
+		}
+	}
+
+	version, err = strconv.Atoi(digits)
+	if err != nil {
+		return 0, "", &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("invalid version token '%s': %v", token, err),
+			Code:    CodeMalformed,
+		}
+	}
+
+	if short == "" {
+		return 0, "", &DecodeError{
+			ShortID: s,
+			Reason:  "versioned ID is missing the short ID",
+			Code:    CodeMalformed,
+		}
+	}
+
+	return version, short, nil
+}
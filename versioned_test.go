@@ -0,0 +1,68 @@
+package shortuuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFormatVersioned(t *testing.T) {
+	testCases := []struct {
+		version  int
+		short    string
+		expected string
+	}{
+		{1, "Grm6f7QVJVuVrufEOTgIC", "v1-Grm6f7QVJVuVrufEOTgIC"},
+		{0, "abc", "v0-abc"},
+		{42, "2XrVqpuNYMfp5OSuawGnL1", "v42-2XrVqpuNYMfp5OSuawGnL1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			formatted := FormatVersioned(tc.version, tc.short)
+			if formatted != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, formatted)
+			}
+
+			version, short, err := ParseVersioned(formatted)
+			if err != nil {
+				t.Fatalf("Error parsing versioned ID %s: %v", formatted, err)
+			}
+
+			if version != tc.version {
+				t.Errorf("Expected version %d, got %d", tc.version, version)
+			}
+
+			if short != tc.short {
+				t.Errorf("Expected short ID %s, got %s", tc.short, short)
+			}
+		})
+	}
+}
+
+func TestParseVersionedMalformed(t *testing.T) {
+	testCases := []string{
+		"",
+		"Grm6f7QVJVuVrufEOTgIC",
+		"v1",
+		"v1-",
+		"-abc",
+		"1-abc",
+		"v-abc",
+		"vx-abc",
+		"v1a-abc",
+		"v-1-abc",
+		"V1-abc",
+		"v99999999999999999999-abc",
+	}
+
+	for _, input := range testCases {
+		t.Run(input, func(t *testing.T) {
+			_, _, err := ParseVersioned(input)
+
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("Expected DecodeError for %q, got %T: %v", input, err, err)
+			}
+		})
+	}
+}
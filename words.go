@@ -0,0 +1,237 @@
+package shortuuid
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// wordSeparator joins the words of an encoded UUID unless WithWordSeparator
+// configures another
+const wordSeparator = "-"
+
+// WordEncoder converts UUIDs to sequences of dictionary words, such as
+// "apple-river-cloud-stone", for IDs meant to be memorized or read aloud.
+// Each word carries log2(len(words)) bits, so a UUID takes 128 divided by that
+// many words, rounded up: 12 words with a 2048-word BIP39-style list, 16 with
+// 256 words. Word IDs are much longer than base62 short IDs.
+// A WordEncoder is safe for concurrent use.
+type WordEncoder struct {
+	words []string
+	index map[string]int
+	bits  int // Bits carried by each word
+	count int // Words per UUID
+
+	separators []string // Separators accepted by Decode; the first joins Encode output
+	maxWordLen int      // Length in bytes of the longest word
+}
+
+// WordOption configures a WordEncoder created by NewWordEncoder.
+type WordOption func(*WordEncoder)
+
+// WithWordSeparator sets the separator that Encode places between words, for
+// locales that prefer something other than '-'. Decode accepts sep and any of
+// the accepted alternatives, so IDs typed with a different separator still
+// decode. An empty separator joins words directly, as some scripts prefer;
+// it requires a prefix-free word list so that words can be told apart.
+func WithWordSeparator(sep string, accepted ...string) WordOption {
+	return func(w *WordEncoder) {
+		w.separators = append([]string{sep}, accepted...)
+	}
+}
+
+// NewWordEncoder creates a WordEncoder over the given word list. The list
+// length must be a power of two and at least 2, and words must be unique,
+// non-empty and free of every separator. If an empty separator is configured,
+// no word may be a prefix of another.
+// Returns an *EncodeError if the word list is invalid.
+func NewWordEncoder(words []string, opts ...WordOption) (*WordEncoder, error) {
+	n := len(words)
+	if n < 2 || n&(n-1) != 0 {
+		return nil, &EncodeError{
+			Input:  fmt.Sprintf("%d words", n),
+			Reason: "word list length must be a power of two and at least 2",
+		}
+	}
+
+	w := &WordEncoder{separators: []string{wordSeparator}}
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	index := make(map[string]int, n)
+	for i, word := range words {
+		if word == "" {
+			return nil, &EncodeError{
+				Input:  word,
+				Reason: fmt.Sprintf("word %d must be non-empty", i),
+			}
+		}
+
+		for _, sep := range w.separators {
+			if sep != "" && strings.Contains(word, sep) {
+				return nil, &EncodeError{
+					Input:  word,
+					Reason: fmt.Sprintf("word %d must not contain the separator '%s'", i, sep),
+				}
+			}
+		}
+
+		if _, ok := index[word]; ok {
+			return nil, &EncodeError{
+				Input:  word,
+				Reason: "word list contains a duplicate",
+			}
+		}
+		index[word] = i
+		w.maxWordLen = max(w.maxWordLen, len(word))
+	}
+
+	if w.acceptsEmptySeparator() {
+		sorted := append([]string(nil), words...)
+		sort.Strings(sorted)
+		for i := 1; i < len(sorted); i++ {
+			if strings.HasPrefix(sorted[i], sorted[i-1]) {
+				return nil, &EncodeError{
+					Input:  sorted[i],
+					Reason: fmt.Sprintf("word list must be prefix-free with an empty separator, but '%s' starts with '%s'", sorted[i], sorted[i-1]),
+				}
+			}
+		}
+	}
+
+	wordBits := bits.TrailingZeros(uint(n))
+	w.words = append([]string(nil), words...)
+	w.index = index
+	w.bits = wordBits
+	w.count = (128 + wordBits - 1) / wordBits
+	return w, nil
+}
+
+// Encode converts a UUID to a fixed number of words joined by the separator,
+// '-' by default.
+// The first word carries the most significant bits.
+func (w *WordEncoder) Encode(u uuid.UUID) string {
+	num := new(big.Int).SetBytes(u[:])
+	mask := big.NewInt(int64(len(w.words) - 1))
+	digit := new(big.Int)
+
+	parts := make([]string, w.count)
+	for i := w.count - 1; i >= 0; i-- {
+		digit.And(num, mask)
+		parts[i] = w.words[digit.Int64()]
+		num.Rsh(num, uint(w.bits))
+	}
+
+	return strings.Join(parts, w.separators[0])
+}
+
+// Decode converts a word sequence created by Encode back to a UUID.
+// Returns a *DecodeError if the word count is wrong, a word is unknown, or
+// the value exceeds 128 bits.
+func (w *WordEncoder) Decode(s string) (uuid.UUID, error) {
+	parts := w.splitWords(s)
+	if len(parts) != w.count {
+		return uuid.UUID{}, &DecodeError{
+			ShortID: s,
+			Reason:  fmt.Sprintf("expected %d words, got %d", w.count, len(parts)),
+			Code:    CodeBadLength,
+		}
+	}
+
+	num := new(big.Int)
+	for _, word := range parts {
+		i, ok := w.index[word]
+		if !ok {
+			return uuid.UUID{}, &DecodeError{
+				ShortID: s,
+				Reason:  fmt.Sprintf("unknown word '%s'", word),
+				Code:    CodeInvalidChar,
+			}
+		}
+
+		num.Lsh(num, uint(w.bits))
+		num.Or(num, big.NewInt(int64(i)))
+	}
+
+	if num.BitLen() > 128 {
+		return uuid.UUID{}, &DecodeError{
+			ShortID: s,
+			Reason:  "decoded value exceeds 128 bits",
+			Code:    CodeOverflow,
+		}
+	}
+
+	var u uuid.UUID
+	num.FillBytes(u[:])
+	return u, nil
+}
+
+// splitWords breaks s into words at any accepted separator. With an empty
+// separator accepted, a word ends where a known word is complete.
+func (w *WordEncoder) splitWords(s string) []string {
+	var parts []string
+	for {
+		word := w.nextWord(s)
+		parts = append(parts, word)
+		s = s[len(word):]
+		if s == "" {
+			return parts
+		}
+
+		sep := w.matchSeparator(s)
+		if sep == "" && word == "" {
+			// No progress is possible; report the rest as one unknown word
+			return append(parts, s)
+		}
+		s = s[len(sep):]
+	}
+}
+
+// nextWord returns the word at the start of s
+func (w *WordEncoder) nextWord(s string) string {
+	if w.acceptsEmptySeparator() {
+		// The list is prefix-free, so at most one word matches
+		for n := 1; n <= min(len(s), w.maxWordLen); n++ {
+			if _, ok := w.index[s[:n]]; ok {
+				return s[:n]
+			}
+		}
+	}
+
+	end := len(s)
+	for _, sep := range w.separators {
+		if sep == "" {
+			continue
+		}
+		if i := strings.Index(s, sep); i >= 0 && i < end {
+			end = i
+		}
+	}
+	return s[:end]
+}
+
+// matchSeparator returns the longest accepted separator at the start of s
+func (w *WordEncoder) matchSeparator(s string) string {
+	longest := ""
+	for _, sep := range w.separators {
+		if len(sep) > len(longest) && strings.HasPrefix(s, sep) {
+			longest = sep
+		}
+	}
+	return longest
+}
+
+// acceptsEmptySeparator reports whether words may be joined without a separator
+func (w *WordEncoder) acceptsEmptySeparator() bool {
+	for _, sep := range w.separators {
+		if sep == "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+package shortuuid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// testWords is a small 16-word list, so each word carries 4 bits
+var testWords = []string{
+	"apple", "river", "cloud", "stone", "maple", "ocean", "tiger", "amber",
+	"cedar", "delta", "ember", "frost", "grove", "harbor", "iris", "jade",
+}
+
+func TestWordEncoder(t *testing.T) {
+	encoder, err := NewWordEncoder(testWords)
+	if err != nil {
+		t.Fatalf("Error creating word encoder: %v", err)
+	}
+
+	testCases := []uuid.UUID{
+		uuid.Nil,
+		uuid.Max,
+		uuid.MustParse("53a8d1b9-4eca-4888-9b59-8fa91497857b"),
+		uuid.New(),
+	}
+
+	for _, u := range testCases {
+		t.Run(u.String(), func(t *testing.T) {
+			encoded := encoder.Encode(u)
+
+			if words := strings.Split(encoded, "-"); len(words) != 32 {
+				t.Errorf("Expected 32 words, got %d in %s", len(words), encoded)
+			}
+
+			decoded, err := encoder.Decode(encoded)
+			if err != nil {
+				t.Fatalf("Error decoding %s: %v", encoded, err)
+			}
+
+			if decoded != u {
+				t.Errorf("Expected %s, got %s", u, decoded)
+			}
+
+			t.Logf("%s -> %s", u, encoded)
+		})
+	}
+
+	// The first word carries the most significant nibble
+	encoded := encoder.Encode(uuid.MustParse("10000000-0000-0000-0000-00000000000f"))
+	if !strings.HasPrefix(encoded, "river-apple-") || !strings.HasSuffix(encoded, "-apple-jade") {
+		t.Errorf("Unexpected word order: %s", encoded)
+	}
+}
+
+func TestWordEncoderUnevenBits(t *testing.T) {
+	// 8 words carry 3 bits each, so 43 words cover 129 bits
+	encoder, err := NewWordEncoder(testWords[:8])
+	if err != nil {
+		t.Fatalf("Error creating word encoder: %v", err)
+	}
+
+	for i := 0; i < 20; i++ {
+		u := uuid.New()
+		decoded, err := encoder.Decode(encoder.Encode(u))
+		if err != nil {
+			t.Fatalf("Error decoding: %v", err)
+		}
+		if decoded != u {
+			t.Errorf("Expected %s, got %s", u, decoded)
+		}
+	}
+
+	// A leading word with its top bit set exceeds 128 bits
+	overflow := strings.TrimSuffix(strings.Repeat("amber-", 43), "-")
+	_, err = encoder.Decode(overflow)
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Errorf("Expected DecodeError for overflow, got %T: %v", err, err)
+	}
+}
+
+func TestNewWordEncoderErrors(t *testing.T) {
+	testCases := map[string][]string{
+		"empty":          nil,
+		"single word":    {"apple"},
+		"not power of 2": {"apple", "river", "cloud"},
+		"duplicate":      {"apple", "river", "cloud", "apple"},
+		"empty word":     {"apple", ""},
+		"separator":      {"apple", "river-bank"},
+	}
+
+	for name, words := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewWordEncoder(words)
+			var encodeErr *EncodeError
+			if !errors.As(err, &encodeErr) {
+				t.Fatalf("Expected EncodeError, got %T: %v", err, err)
+			}
+		})
+	}
+}
+
+func TestWordEncoderDecodeErrors(t *testing.T) {
+	encoder, err := NewWordEncoder(testWords)
+	if err != nil {
+		t.Fatalf("Error creating word encoder: %v", err)
+	}
+
+	valid := encoder.Encode(uuid.New())
+	testCases := []string{
+		"",
+		"apple-river",
+		valid + "-apple",
+		strings.Replace(valid, strings.Split(valid, "-")[0], "banana", 1),
+	}
+
+	for i, input := range testCases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			_, err := encoder.Decode(input)
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("Expected DecodeError for %q, got %T: %v", input, err, err)
+			}
+		})
+	}
+}
+
+func TestWithWordSeparator(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sep      string
+		accepted []string
+		inputs   func(encoded string) []string
+	}{
+		{
+			name: "space",
+			sep:  " ",
+			inputs: func(encoded string) []string {
+				return []string{encoded}
+			},
+		},
+		{
+			name:     "middle dot accepting hyphen",
+			sep:      "·",
+			accepted: []string{"-"},
+			inputs: func(encoded string) []string {
+				return []string{encoded, strings.ReplaceAll(encoded, "·", "-")}
+			},
+		},
+		{
+			name:     "no separator accepting hyphen and space",
+			sep:      "",
+			accepted: []string{"-", " "},
+			inputs: func(encoded string) []string {
+				return []string{encoded, testWords[3] + "-" + encoded[len(testWords[3]):]}
+			},
+		},
+	}
+
+	u := uuid.MustParse("30000000-0000-0000-0000-00000000000f")
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encoder, err := NewWordEncoder(testWords, WithWordSeparator(tc.sep, tc.accepted...))
+			if err != nil {
+				t.Fatalf("Error creating word encoder: %v", err)
+			}
+
+			encoded := encoder.Encode(u)
+			expected := "stone" + strings.Repeat(tc.sep+"apple", 30) + tc.sep + "jade"
+			if encoded != expected {
+				t.Errorf("Expected %s, got %s", expected, encoded)
+			}
+
+			for _, input := range tc.inputs(encoded) {
+				decoded, err := encoder.Decode(input)
+				if err != nil {
+					t.Fatalf("Error decoding %s: %v", input, err)
+				}
+
+				if decoded != u {
+					t.Errorf("Expected %s, got %s", u, decoded)
+				}
+			}
+
+			random := uuid.New()
+			decoded, err := encoder.Decode(encoder.Encode(random))
+			if err != nil {
+				t.Fatalf("Error decoding: %v", err)
+			}
+
+			if decoded != random {
+				t.Errorf("Expected %s, got %s", random, decoded)
+			}
+		})
+	}
+}
+
+func TestWithWordSeparatorInvalid(t *testing.T) {
+	// Words must not contain any accepted separator
+	_, err := NewWordEncoder(testWords, WithWordSeparator(" ", "e"))
+	var encodeErr *EncodeError
+	if !errors.As(err, &encodeErr) {
+		t.Errorf("Expected EncodeError for separator inside a word, got %T: %v", err, err)
+	}
+
+	// An empty separator needs a prefix-free list
+	words := append([]string{"app"}, testWords[1:]...)
+	words[1] = "apple"
+	_, err = NewWordEncoder(words, WithWordSeparator(""))
+	if !errors.As(err, &encodeErr) {
+		t.Errorf("Expected EncodeError for prefix clash, got %T: %v", err, err)
+	}
+
+	// The same list is fine with a separator
+	if _, err := NewWordEncoder(words, WithWordSeparator(" ")); err != nil {
+		t.Errorf("Expected list with prefixes to be accepted with a separator, got %v", err)
+	}
+
+	encoder, err := NewWordEncoder(testWords, WithWordSeparator(""))
+	if err != nil {
+		t.Fatalf("Error creating word encoder: %v", err)
+	}
+
+	for _, input := range []string{"", "applebanana", strings.Repeat("apple", 31) + "jad"} {
+		_, err := encoder.Decode(input)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) {
+			t.Errorf("Expected DecodeError for %q, got %T: %v", input, err, err)
+		}
+	}
+}